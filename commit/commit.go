@@ -10,5 +10,7 @@ type Verifier[C Scheme] interface {
 	Verify(Commitment[C], Decommitment[C], []byte) error
 }
 
-type Commitment[C Scheme] interface{}
-type Decommitment[C Scheme] interface{}
+// Commitment and Decommitment are byte strings so that they can be
+// transmitted without knowledge of the scheme.
+type Commitment[C Scheme] []byte
+type Decommitment[C Scheme] []byte
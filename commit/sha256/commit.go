@@ -10,6 +10,8 @@ import (
 
 type Scheme struct{}
 
+const nonceSize = 32
+
 type Committer struct {
 	rnd io.Reader
 }
@@ -21,17 +23,18 @@ func NewCommitter(rnd io.Reader) *Committer {
 }
 
 func (c *Committer) Commit(data []byte) (commit.Commitment[Scheme], commit.Decommitment[Scheme], error) {
-	var nonce [32]byte
-	_, err := c.rnd.Read(nonce[:])
+	nonce := make([]byte, nonceSize)
+	_, err := io.ReadFull(c.rnd, nonce)
 	if err != nil {
 		return nil, nil, fmt.Errorf("reading rng: %w", err)
 	}
+	return hash(nonce, data), nonce, nil
+}
 
+// hash computes SHA-256(nonce || data).
+func hash(nonce, data []byte) []byte {
 	hasher := sha256.New()
-	_, err = hasher.Write(nonce[:])
-	if err != nil {
-		return nil, nil, fmt.Errorf("writing nonce: %w", err)
-	}
-	h := hasher.Sum(data)
-	return h, nonce, nil
+	hasher.Write(nonce)
+	hasher.Write(data)
+	return hasher.Sum(nil)
 }
@@ -1,8 +1,7 @@
 package sha256
 
 import (
-	"bytes"
-	"crypto/sha256"
+	"crypto/subtle"
 	"fmt"
 
 	"github.com/matthiasgeihs/go-curve/commit"
@@ -15,16 +14,11 @@ func NewVerifier() *Verifier {
 }
 
 func (Verifier) Verify(com commit.Commitment[Scheme], decom commit.Decommitment[Scheme], data []byte) error {
-	nonce := decom.([32]byte)
-	hCom := com.([]byte)
-
-	hasher := sha256.New()
-	_, err := hasher.Write(nonce[:])
-	if err != nil {
-		return fmt.Errorf("writing nonce: %w", err)
+	if len(decom) != nonceSize {
+		return fmt.Errorf("invalid decommitment length")
 	}
-	hVer := hasher.Sum(data)
-	if !bytes.Equal(hCom, hVer) {
+	hVer := hash(decom, data)
+	if subtle.ConstantTimeCompare(com, hVer) != 1 {
 		return fmt.Errorf("invalid decommitment")
 	}
 	return nil
@@ -10,11 +10,33 @@ type Curve interface{}
 // Curve with prime order subgroup.
 type Generator[C Curve] interface {
 	Generator() Point[C]
+	Identity() Point[C]
+	// Name returns the identifier of the curve, such as "P-256" or
+	// "secp256k1".
+	Name() string
 	GeneratorOrder() *big.Int
+	// MulBase returns s times the generator. Implementations use precomputed
+	// tables and run in constant time with respect to s where the backend
+	// supports it.
+	MulBase(Scalar[C]) Point[C]
 	NewPoint(*big.Int, *big.Int) Point[C]
+	// DecodePoint decodes a point from its canonical encoding. It rejects
+	// non-canonical, off-curve and small order inputs, including the identity,
+	// and only returns points of the prime-order subgroup.
+	DecodePoint([]byte) (Point[C], error)
+	// RandomScalar samples a uniformly random non-zero scalar.
 	RandomScalar(io.Reader) (Scalar[C], error)
 	NewScalar(*big.Int) Scalar[C]
-	HashToScalar([]byte) Scalar[C]
+	Zero() Scalar[C]
+	One() Scalar[C]
+	// DecodeScalar decodes a scalar from its canonical fixed-length encoding.
+	DecodeScalar([]byte) (Scalar[C], error)
+	// HashToScalar hashes a message to a uniformly distributed scalar using the
+	// given domain separation tag, following RFC 9380.
+	HashToScalar(dst, msg []byte) Scalar[C]
+	// HashToPoint hashes a message to a point with unknown discrete logarithm
+	// using the given domain separation tag, following RFC 9380.
+	HashToPoint(dst, msg []byte) Point[C]
 	EncodeToPoint([]byte) (Point[C], error)
 	DecodeFromPoint(Point[C]) []byte
 }
@@ -24,6 +46,15 @@ type Point[C Curve] interface {
 	Y() *big.Int
 	Add(q Point[C]) Point[C]
 	Mul(Scalar[C]) Point[C]
+	Sub(q Point[C]) Point[C]
+	Neg() Point[C]
+	Double() Point[C]
+	Equal(Point[C]) bool
+	IsIdentity() bool
+	// Bytes returns the canonical encoding of the point.
+	Bytes() []byte
+	// CompressedBytes returns the canonical compressed encoding of the point.
+	CompressedBytes() []byte
 }
 
 type Scalar[C Curve] interface {
@@ -33,4 +64,8 @@ type Scalar[C Curve] interface {
 	Mul(Scalar[C]) Scalar[C]
 	Int() *big.Int
 	Equal(Scalar[C]) bool
+	Neg() Scalar[C]
+	IsZero() bool
+	// Bytes returns the canonical fixed-length encoding of the scalar.
+	Bytes() []byte
 }
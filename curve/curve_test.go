@@ -0,0 +1,363 @@
+package curve_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve/p256"
+	"github.com/matthiasgeihs/go-curve/curve/p384"
+	"github.com/matthiasgeihs/go-curve/curve/p521"
+	"github.com/matthiasgeihs/go-curve/curve/ristretto255"
+	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
+)
+
+func TestGroup(t *testing.T) {
+	t.Run("secp256k1", func(t *testing.T) {
+		testGroup[secp256k1.Curve](t, secp256k1.NewGenerator())
+	})
+	t.Run("edwards25519", func(t *testing.T) {
+		testGroup[edwards25519.Curve](t, edwards25519.NewGenerator())
+	})
+	t.Run("ristretto255", func(t *testing.T) {
+		testGroup[ristretto255.Curve](t, ristretto255.NewGenerator())
+	})
+	t.Run("p256", func(t *testing.T) {
+		testGroup[p256.Curve](t, p256.NewGenerator())
+	})
+	t.Run("p384", func(t *testing.T) {
+		testGroup[p384.Curve](t, p384.NewGenerator())
+	})
+	t.Run("p521", func(t *testing.T) {
+		testGroup[p521.Curve](t, p521.NewGenerator())
+	})
+}
+
+func TestName(t *testing.T) {
+	names := map[string]string{
+		secp256k1.NewGenerator().Name():    "secp256k1",
+		edwards25519.NewGenerator().Name(): "edwards25519",
+		ristretto255.NewGenerator().Name(): "ristretto255",
+		p256.NewGenerator().Name():         "P-256",
+		p384.NewGenerator().Name():         "P-384",
+		p521.NewGenerator().Name():         "P-521",
+	}
+	for name, want := range names {
+		if name != want {
+			t.Errorf("curve name should be %s, got %s", want, name)
+		}
+	}
+	if len(names) != 6 {
+		t.Error("curve names should be distinct")
+	}
+}
+
+func testGroup[C curve.Curve](t *testing.T, g curve.Generator[C]) {
+	a, err := g.RandomScalar(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := g.RandomScalar(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, q := g.Generator().Mul(a), g.Generator().Mul(b)
+	id := g.Identity()
+
+	check := func(name string, ok bool) {
+		if !ok {
+			t.Errorf("%s should hold", name)
+		}
+	}
+	check("id is identity", id.IsIdentity())
+	check("p is not identity", !p.IsIdentity())
+	check("p + id == p", p.Add(id).Equal(p))
+	check("p - p == id", p.Sub(p).IsIdentity())
+	check("p + (-p) == id", p.Add(p.Neg()).IsIdentity())
+	check("p != -p", !p.Equal(p.Neg()))
+	check("p + p == 2p", p.Add(p).Equal(p.Double()))
+	check("(p + q) - q == p", p.Add(q).Sub(q).Equal(p))
+	check("a*g + b*g == (a+b)*g", p.Add(q).Equal(g.Generator().Mul(a.Add(b))))
+	check("0*g == id", g.Generator().Mul(g.Zero()).IsIdentity())
+	check("1*g == g", g.Generator().Mul(g.One()).Equal(g.Generator()))
+	check("order*g == id", g.Generator().Mul(g.NewScalar(g.GeneratorOrder())).IsIdentity())
+	check("base a*g == a*g", g.MulBase(a).Equal(p))
+	check("base 0*g == id", g.MulBase(g.Zero()).IsIdentity())
+	check("base 1*g == g", g.MulBase(g.One()).Equal(g.Generator()))
+	check("base (-1)*g == -g", g.MulBase(g.One().Neg()).Equal(g.Generator().Neg()))
+
+	check("zero is zero", g.Zero().IsZero())
+	check("one is not zero", !g.One().IsZero())
+	check("a + (-a) == 0", a.Add(a.Neg()).IsZero())
+	check("a - b == a + (-b)", a.Sub(b).Equal(a.Add(b.Neg())))
+	check("a - b leaves b unchanged", func() bool {
+		bCopy := g.NewScalar(b.Int())
+		a.Sub(b)
+		return b.Equal(bCopy)
+	}())
+	check("a * a^-1 == 1", a.Mul(a.Inv()).Equal(g.One()))
+
+	check("secret a*p == a*p", curve.MulSecret(p, a).Equal(p.Mul(a)))
+	check("secret 0*p == id", curve.MulSecret(p, g.Zero()).IsIdentity())
+	check("secret 1*p == p", curve.MulSecret(p, g.One()).Equal(p))
+	check("secret (-1)*p == -p", curve.MulSecret(p, g.One().Neg()).Equal(p.Neg()))
+	check("secret a*id == id", curve.MulSecret(id, a).IsIdentity())
+	check("secret a^-1 == a^-1", curve.InvSecret(a).Equal(a.Inv()))
+	check("secret 0^-1 == 0", curve.InvSecret(g.Zero()).IsZero())
+}
+
+func TestScalar(t *testing.T) {
+	t.Run("secp256k1", func(t *testing.T) {
+		testScalar[secp256k1.Curve](t, secp256k1.NewGenerator())
+	})
+	t.Run("edwards25519", func(t *testing.T) {
+		testScalar[edwards25519.Curve](t, edwards25519.NewGenerator())
+	})
+	t.Run("ristretto255", func(t *testing.T) {
+		testScalar[ristretto255.Curve](t, ristretto255.NewGenerator())
+	})
+	t.Run("p256", func(t *testing.T) {
+		testScalar[p256.Curve](t, p256.NewGenerator())
+	})
+	t.Run("p384", func(t *testing.T) {
+		testScalar[p384.Curve](t, p384.NewGenerator())
+	})
+	t.Run("p521", func(t *testing.T) {
+		testScalar[p521.Curve](t, p521.NewGenerator())
+	})
+}
+
+func testScalar[C curve.Curve](t *testing.T, g curve.Generator[C]) {
+	t.Run("random", func(t *testing.T) {
+		// Zero bytes must be rejected and more randomness consumed.
+		rnd := io.MultiReader(bytes.NewReader(make([]byte, 128)), rand.Reader)
+		s, err := g.RandomScalar(rnd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.IsZero() {
+			t.Error("random scalar should not be zero")
+		}
+
+		// Bytes exceeding the group order must be rejected or reduced.
+		rnd = io.MultiReader(bytes.NewReader(bytes.Repeat([]byte{0xff}, 128)), rand.Reader)
+		s, err = g.RandomScalar(rnd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Int().Cmp(g.GeneratorOrder()) >= 0 {
+			t.Error("random scalar should be reduced")
+		}
+
+		_, err = g.RandomScalar(bytes.NewReader(nil))
+		if err == nil {
+			t.Error("should fail on exhausted randomness")
+		}
+	})
+
+	t.Run("hash", func(t *testing.T) {
+		dst1, dst2 := []byte("dst1"), []byte("dst2")
+		msg1, msg2 := []byte("msg1"), []byte("msg2")
+		s := g.HashToScalar(dst1, msg1)
+		if !s.Equal(g.HashToScalar(dst1, msg1)) {
+			t.Error("hash should be deterministic")
+		}
+		if s.Equal(g.HashToScalar(dst2, msg1)) {
+			t.Error("hash should depend on domain separation tag")
+		}
+		if s.Equal(g.HashToScalar(dst1, msg2)) {
+			t.Error("hash should depend on message")
+		}
+	})
+}
+
+func TestMultiScalarMul(t *testing.T) {
+	t.Run("secp256k1", func(t *testing.T) {
+		testMultiScalarMul[secp256k1.Curve](t, secp256k1.NewGenerator())
+	})
+	t.Run("edwards25519", func(t *testing.T) {
+		testMultiScalarMul[edwards25519.Curve](t, edwards25519.NewGenerator())
+	})
+	t.Run("ristretto255", func(t *testing.T) {
+		testMultiScalarMul[ristretto255.Curve](t, ristretto255.NewGenerator())
+	})
+	t.Run("p256", func(t *testing.T) {
+		testMultiScalarMul[p256.Curve](t, p256.NewGenerator())
+	})
+}
+
+func testMultiScalarMul[C curve.Curve](t *testing.T, g curve.Generator[C]) {
+	// The sizes cover both Straus' and Pippenger's method.
+	for _, n := range []int{1, 2, 7, 100} {
+		points := make([]curve.Point[C], n)
+		scalars := make([]curve.Scalar[C], n)
+		want := g.Identity()
+		for i := range points {
+			a, err := g.RandomScalar(rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := g.RandomScalar(rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			points[i], scalars[i] = g.MulBase(a), b
+			switch i % 5 {
+			case 1:
+				scalars[i] = g.Zero()
+			case 2:
+				points[i] = g.Identity()
+			case 3:
+				points[i] = points[i-3]
+			}
+			want = want.Add(points[i].Mul(scalars[i]))
+		}
+
+		if got := curve.MultiScalarMul(points, scalars); !got.Equal(want) {
+			t.Errorf("n = %d: result should match the sum of products", n)
+		}
+	}
+
+	t.Run("mismatch", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("should panic on length mismatch")
+			}
+		}()
+		curve.MultiScalarMul([]curve.Point[C]{g.Generator()}, nil)
+	})
+}
+
+func TestEncoding_secp256k1(t *testing.T) {
+	testEncoding[secp256k1.Curve](t, secp256k1.NewGenerator(), []string{
+		// Point at infinity.
+		"00",
+		// Hybrid encoding.
+		"0679be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8",
+		// x-coordinate exceeding the field order.
+		"02fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc30",
+	})
+}
+
+func TestEncoding_edwards25519(t *testing.T) {
+	testEncoding[edwards25519.Curve](t, edwards25519.NewGenerator(), []string{
+		// Identity.
+		"0100000000000000000000000000000000000000000000000000000000000000",
+		// Identity with sign bit set, which is non-canonical.
+		"0100000000000000000000000000000000000000000000000000000000000080",
+		// Point of order 2.
+		"ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		// Generator plus the point of order 2, which is not in the
+		// prime-order subgroup.
+		"9599999999999999999999999999999999999999999999999999999999999999",
+		// y-coordinate exceeding the field order.
+		"eeffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+	})
+}
+
+func TestEncoding_ristretto255(t *testing.T) {
+	testEncoding[ristretto255.Curve](t, ristretto255.NewGenerator(), []string{
+		// Identity.
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		// Negative field element.
+		"0100000000000000000000000000000000000000000000000000000000000000",
+		// Field element exceeding the field order.
+		"edffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+	})
+}
+
+func TestEncoding_p256(t *testing.T) {
+	testEncoding[p256.Curve](t, p256.NewGenerator(), []string{
+		// Point at infinity.
+		"00",
+		// x-coordinate exceeding the field order.
+		"02ffffffff00000001000000000000000000000001000000000000000000000000",
+		// Generator with y-coordinate incremented, which is not on the curve.
+		"046b17d1f2e12c4247f8bce6e563a440f277037d812deb33a0f4a13945d898c2964fe342e2fe1a7f9b8ee7eb4a7c0f9e162bce33576b315ececbb6406837bf51f6",
+	})
+}
+
+func TestEncoding_p384(t *testing.T) {
+	testEncoding[p384.Curve](t, p384.NewGenerator(), []string{"00"})
+}
+
+func TestEncoding_p521(t *testing.T) {
+	testEncoding[p521.Curve](t, p521.NewGenerator(), []string{"00"})
+}
+
+func testEncoding[C curve.Curve](
+	t *testing.T,
+	g curve.Generator[C],
+	invalidPoints []string,
+) {
+	s, err := g.RandomScalar(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := g.Generator().Mul(s)
+
+	t.Run("point", func(t *testing.T) {
+		for _, b := range [][]byte{p.Bytes(), p.CompressedBytes()} {
+			q, err := g.DecodePoint(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(p.Bytes(), q.Bytes()) {
+				t.Error("decoded point should equal encoded point")
+			}
+		}
+	})
+
+	t.Run("scalar", func(t *testing.T) {
+		u, err := g.DecodeScalar(s.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !s.Equal(u) {
+			t.Error("decoded scalar should equal encoded scalar")
+		}
+	})
+
+	t.Run("invalid point", func(t *testing.T) {
+		b := p.Bytes()
+		invalid := [][]byte{nil, b[:len(b)-1], append(b, 0)}
+
+		// Changing the last byte of an uncompressed encoding moves the point
+		// off the curve.
+		if len(b) > len(p.CompressedBytes()) {
+			offCurve := append([]byte{}, b...)
+			offCurve[len(offCurve)-1] ^= 1
+			invalid = append(invalid, offCurve)
+		}
+
+		for _, s := range invalidPoints {
+			b, err := hex.DecodeString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			invalid = append(invalid, b)
+		}
+
+		for _, b := range invalid {
+			_, err := g.DecodePoint(b)
+			if err == nil {
+				t.Errorf("decoding %x should fail", b)
+			}
+		}
+	})
+
+	t.Run("invalid scalar", func(t *testing.T) {
+		l := len(s.Bytes())
+		invalid := [][]byte{nil, make([]byte, l-1), make([]byte, l+1), bytes.Repeat([]byte{0xff}, l)}
+		for _, b := range invalid {
+			_, err := g.DecodeScalar(b)
+			if err == nil {
+				t.Errorf("decoding %x should fail", b)
+			}
+		}
+	})
+}
@@ -1,12 +1,13 @@
 package edwards25519
 
 import (
-	"crypto/sha256"
+	"crypto/sha512"
 	"io"
 	"math/big"
 
 	"filippo.io/edwards25519"
 	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/internal/h2c"
 )
 
 type Curve struct {
@@ -17,6 +18,7 @@ var generatorOrder, _ = new(big.Int).SetString("10000000000000000000000000000000
 var fieldOrder, _ = new(big.Int).SetString("7FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFED", 16)
 
 const scalarByteSize = 32
+const uniformScalarByteSize = 64
 const fieldElementSize = 32
 
 // Check that type implements interface.
@@ -40,41 +42,86 @@ func (Curve) NewPoint(x, y *big.Int) curve.Point[Curve] {
 	return makePointFromAffine(x, y)
 }
 
+func (Curve) DecodePoint(b []byte) (curve.Point[Curve], error) {
+	p, err := decodePoint(b)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
 func (Curve) Generator() curve.Point[Curve] {
 	g := edwards25519.NewGeneratorPoint()
 	return makePoint(g)
 }
 
+// MulBase returns s times the generator using the precomputed tables of the
+// edwards25519 package.
+func (Curve) MulBase(s curve.Scalar[Curve]) curve.Point[Curve] {
+	prod := new(edwards25519.Point).ScalarBaseMult(s.(Scalar).v)
+	return makePoint(prod)
+}
+
+func (Curve) Identity() curve.Point[Curve] {
+	return makePoint(edwards25519.NewIdentityPoint())
+}
+
+func (Curve) Name() string {
+	return "edwards25519"
+}
+
 func (Curve) GeneratorOrder() *big.Int {
 	return new(big.Int).Set(generatorOrder)
 }
 
+// RandomScalar samples a uniformly random non-zero scalar by reducing 64
+// random bytes modulo the group order.
 func (Curve) RandomScalar(rand io.Reader) (curve.Scalar[Curve], error) {
-	buf := make([]byte, scalarByteSize)
-	_, err := rand.Read(buf)
-	if err != nil {
-		return nil, err
+	buf := make([]byte, uniformScalarByteSize)
+	for {
+		_, err := io.ReadFull(rand, buf)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := edwards25519.NewScalar().SetUniformBytes(buf)
+		if err != nil {
+			return nil, err
+		}
+		if s := makeScalar(v); !s.IsZero() {
+			return s, nil
+		}
 	}
-
-	rbi := new(big.Int).SetBytes(buf)
-	return makeScalarFromBigInt(rbi), nil
 }
 
 func (Curve) NewScalar(v *big.Int) curve.Scalar[Curve] {
 	return makeScalarFromBigInt(v)
 }
 
-func (Curve) HashToScalar(data []byte) curve.Scalar[Curve] {
-	h := sha256.Sum256(data)
-	bi := new(big.Int).SetBytes(h[:])
-	bi.Mod(bi, generatorOrder)
-	le := littleEndian(bi, scalarByteSize)
-	var v edwards25519.Scalar
-	_, err := v.SetCanonicalBytes(le)
+func (Curve) Zero() curve.Scalar[Curve] {
+	return makeScalar(edwards25519.NewScalar())
+}
+
+func (Curve) One() curve.Scalar[Curve] {
+	return makeScalarFromBigInt(big.NewInt(1))
+}
+
+func (Curve) DecodeScalar(b []byte) (curve.Scalar[Curve], error) {
+	s, err := decodeScalar(b)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// HashToScalar hashes msg to a scalar using hash_to_field from RFC 9380 with
+// expand_message_xmd, SHA-512 and domain separation tag dst.
+func (Curve) HashToScalar(dst, msg []byte) curve.Scalar[Curve] {
+	u, err := h2c.HashToField(sha512.New, msg, dst, generatorOrder, 1, hashToFieldLength)
 	if err != nil {
 		panic(err)
 	}
-	return makeScalar(&v)
+	return makeScalarFromBigInt(u[0])
 }
 
 func (c Curve) EncodeToPoint(data []byte) (curve.Point[Curve], error) {
@@ -84,3 +131,10 @@ func (c Curve) EncodeToPoint(data []byte) (curve.Point[Curve], error) {
 func (c Curve) DecodeFromPoint(p curve.Point[Curve]) []byte {
 	return c.encoder.DecodeFromPoint(p)
 }
+
+// HashToPoint hashes msg to a point of the prime order subgroup using the
+// suite edwards25519_XMD:SHA-512_ELL2_RO_ from RFC 9380 with domain separation
+// tag dst.
+func (Curve) HashToPoint(dst, msg []byte) curve.Point[Curve] {
+	return hashToPoint(dst, msg)
+}
@@ -0,0 +1,111 @@
+package edwards25519
+
+import (
+	"crypto/sha512"
+	"math/big"
+
+	"filippo.io/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve/internal/h2c"
+)
+
+// Parameters of the suite edwards25519_XMD:SHA-512_ELL2_RO_ from Section 8.5
+// of RFC 9380. The Elligator 2 map is applied to curve25519 and the result is
+// mapped to edwards25519 with the birational map from Section 6.8.2.
+const hashToFieldLength = 48
+
+// montgomeryA is the constant J of curve25519.
+var montgomeryA = big.NewInt(486662)
+
+// ell2Z is the non-square constant Z of the Elligator 2 map.
+var ell2Z = big.NewInt(2)
+
+// sqrtMinusAMinus2 is sqrt(-486664) with sgn0 equal to zero.
+var sqrtMinusAMinus2 = func() *big.Int {
+	v := new(big.Int).Sub(fieldOrder, big.NewInt(486664))
+	r := new(big.Int).ModSqrt(v, fieldOrder)
+	if h2c.Sgn0(r) != 0 {
+		r.Sub(fieldOrder, r)
+	}
+	return r
+}()
+
+// mapToCurve maps a field element to a point using the Elligator 2 map for
+// curve25519 followed by the birational map to edwards25519.
+func mapToCurve(u *big.Int) *edwards25519.Point {
+	p := fieldOrder
+
+	// x1 = -J * inv0(1 + Z * u^2), or -J if x1 == 0.
+	x1 := new(big.Int).Mul(u, u)
+	x1.Mul(x1, ell2Z)
+	x1.Add(x1, big.NewInt(1))
+	x1 = inv0(x1)
+	x1.Mul(x1, montgomeryA)
+	x1.Neg(x1)
+	x1.Mod(x1, p)
+	if x1.Sign() == 0 {
+		x1.Sub(p, montgomeryA)
+	}
+	gx1 := montgomeryRHS(x1)
+
+	// x2 = -x1 - J
+	x2 := new(big.Int).Add(x1, montgomeryA)
+	x2.Neg(x2)
+	x2.Mod(x2, p)
+	gx2 := montgomeryRHS(x2)
+
+	var s, t *big.Int
+	if y1 := new(big.Int).ModSqrt(gx1, p); y1 != nil {
+		s, t = x1, y1
+		if h2c.Sgn0(t) != 1 {
+			t.Sub(p, t)
+		}
+	} else {
+		s, t = x2, new(big.Int).ModSqrt(gx2, p)
+		if h2c.Sgn0(t) != 0 {
+			t.Sub(p, t)
+		}
+	}
+
+	// (v, w) = (sqrt(-486664) * s / t, (s - 1) / (s + 1))
+	sPlusOne := new(big.Int).Add(s, big.NewInt(1))
+	sPlusOne.Mod(sPlusOne, p)
+	if t.Sign() == 0 || sPlusOne.Sign() == 0 {
+		return edwards25519.NewIdentityPoint()
+	}
+	v := new(big.Int).Mul(sqrtMinusAMinus2, s)
+	v.Mul(v, inv0(t))
+	v.Mod(v, p)
+	w := new(big.Int).Sub(s, big.NewInt(1))
+	w.Mul(w, inv0(sPlusOne))
+	w.Mod(w, p)
+	return makePointFromAffine(v, w).p
+}
+
+// montgomeryRHS computes x^3 + J*x^2 + x.
+func montgomeryRHS(x *big.Int) *big.Int {
+	r := new(big.Int).Add(x, montgomeryA)
+	r.Mul(r, x)
+	r.Add(r, big.NewInt(1))
+	r.Mul(r, x)
+	return r.Mod(r, fieldOrder)
+}
+
+// inv0 computes the inverse of a, mapping zero to zero.
+func inv0(a *big.Int) *big.Int {
+	r := new(big.Int).ModInverse(a, fieldOrder)
+	if r == nil {
+		return new(big.Int)
+	}
+	return r
+}
+
+func hashToPoint(dst, msg []byte) Point {
+	u, err := h2c.HashToField(sha512.New, msg, dst, fieldOrder, 2, hashToFieldLength)
+	if err != nil {
+		panic(err)
+	}
+	q0 := mapToCurve(u[0])
+	q1 := mapToCurve(u[1])
+	r := new(edwards25519.Point).Add(q0, q1)
+	return makePoint(r.MultByCofactor(r))
+}
@@ -1,6 +1,7 @@
 package edwards25519
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 
@@ -15,6 +16,7 @@ type Point struct {
 
 // Check that type implements interface.
 var _ curve.Point[Curve] = Point{}
+var _ curve.MultiScalarMulPoint[Curve] = Point{}
 
 func makePoint(p *edwards25519.Point) Point {
 	return Point{
@@ -35,7 +37,8 @@ func makePointFromAffine(x, y *big.Int) Point {
 	return makePoint(jp)
 }
 
-// makePointFromAffineX computes a point from an x-coordinate.
+// makePointFromAffineX computes a point of the prime-order subgroup from an
+// x-coordinate.
 func makePointFromAffineX(x *big.Int) (Point, error) {
 	xf := newFieldElement(x)
 
@@ -58,13 +61,20 @@ func makePointFromAffineX(x *big.Int) (Point, error) {
 		return Point{}, fmt.Errorf("failed to compute square root")
 	}
 
+	// Of the points (x, y) and (x, -y), at most one is in the prime-order
+	// subgroup, as they differ by a point of order 2 up to negation.
 	zf := newFieldElement(big.NewInt(1))
-	tf := new(field.Element).Multiply(xf, yf)
-	p, err := new(edwards25519.Point).SetExtendedCoordinates(xf, yf, zf, tf)
-	if err != nil {
-		return Point{}, fmt.Errorf("creating point from extended coordinates: %w", err)
+	for _, yf := range []*field.Element{yf, new(field.Element).Negate(yf)} {
+		tf := new(field.Element).Multiply(xf, yf)
+		p, err := new(edwards25519.Point).SetExtendedCoordinates(xf, yf, zf, tf)
+		if err != nil {
+			return Point{}, fmt.Errorf("creating point from extended coordinates: %w", err)
+		}
+		if isTorsionFree(p) {
+			return makePoint(p), nil
+		}
 	}
-	return makePoint(p), nil
+	return Point{}, fmt.Errorf("point not in prime-order subgroup")
 }
 
 func newFieldElement(v *big.Int) *field.Element {
@@ -109,3 +119,86 @@ func (p Point) Mul(s curve.Scalar[Curve]) curve.Point[Curve] {
 	prod := new(edwards25519.Point).ScalarMult(s.(Scalar).v, p.p)
 	return makePoint(prod)
 }
+
+// MultiScalarMul returns the sum of scalars[i]*points[i] using the
+// variable-time multi-scalar multiplication of the edwards25519 package.
+func (Point) MultiScalarMul(
+	points []curve.Point[Curve],
+	scalars []curve.Scalar[Curve],
+) curve.Point[Curve] {
+	ps := make([]*edwards25519.Point, len(points))
+	ss := make([]*edwards25519.Scalar, len(scalars))
+	for i := range points {
+		ps[i] = points[i].(Point).p
+		ss[i] = scalars[i].(Scalar).v
+	}
+	prod := new(edwards25519.Point).VarTimeMultiScalarMult(ss, ps)
+	return makePoint(prod)
+}
+
+func (p Point) Equal(q curve.Point[Curve]) bool {
+	return p.p.Equal(q.(Point).p) == 1
+}
+
+func (p Point) Neg() curve.Point[Curve] {
+	neg := new(edwards25519.Point).Negate(p.p)
+	return makePoint(neg)
+}
+
+func (p Point) Sub(q curve.Point[Curve]) curve.Point[Curve] {
+	diff := new(edwards25519.Point).Subtract(p.p, q.(Point).p)
+	return makePoint(diff)
+}
+
+func (p Point) Double() curve.Point[Curve] {
+	double := new(edwards25519.Point).Add(p.p, p.p)
+	return makePoint(double)
+}
+
+func (p Point) IsIdentity() bool {
+	return p.Equal(makePoint(edwards25519.NewIdentityPoint()))
+}
+
+// Bytes returns the 32-byte encoding of p specified in RFC 8032.
+func (p Point) Bytes() []byte {
+	return p.p.Bytes()
+}
+
+// CompressedBytes returns the 32-byte encoding of p specified in RFC 8032,
+// which is already compressed.
+func (p Point) CompressedBytes() []byte {
+	return p.p.Bytes()
+}
+
+// decodePoint decodes a point from its RFC 8032 encoding. It rejects
+// non-canonical encodings and points outside the prime-order subgroup, so that
+// decoded points never have a small-order component.
+func decodePoint(b []byte) (Point, error) {
+	if len(b) != fieldElementSize {
+		return Point{}, fmt.Errorf("invalid length: %d", len(b))
+	}
+	p, err := new(edwards25519.Point).SetBytes(b)
+	if err != nil {
+		return Point{}, fmt.Errorf("decoding point: %w", err)
+	}
+	if !bytes.Equal(p.Bytes(), b) {
+		return Point{}, fmt.Errorf("non-canonical encoding")
+	}
+	cofactorP := new(edwards25519.Point).MultByCofactor(p)
+	if cofactorP.Equal(edwards25519.NewIdentityPoint()) == 1 {
+		return Point{}, fmt.Errorf("point of small order")
+	}
+	if !isTorsionFree(p) {
+		return Point{}, fmt.Errorf("point not in prime-order subgroup")
+	}
+	return makePoint(p), nil
+}
+
+// isTorsionFree reports whether q*p is the identity for the group order q.
+// Since scalars are reduced modulo q, it checks (q-1)*p == -p instead.
+func isTorsionFree(p *edwards25519.Point) bool {
+	one, _ := edwards25519.NewScalar().SetCanonicalBytes(append([]byte{1}, make([]byte, scalarByteSize-1)...))
+	minusOne := edwards25519.NewScalar().Negate(one)
+	qp := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(minusOne, p, edwards25519.NewScalar())
+	return qp.Equal(new(edwards25519.Point).Negate(p)) == 1
+}
@@ -1,6 +1,7 @@
 package edwards25519
 
 import (
+	"fmt"
 	"math/big"
 
 	"filippo.io/edwards25519"
@@ -78,3 +79,30 @@ func (s Scalar) Int() *big.Int {
 func (s Scalar) Equal(t curve.Scalar[Curve]) bool {
 	return s.v.Equal(t.(Scalar).v) == 1
 }
+
+func (s Scalar) Neg() curve.Scalar[Curve] {
+	neg := edwards25519.NewScalar().Negate(s.v)
+	return makeScalar(neg)
+}
+
+func (s Scalar) IsZero() bool {
+	return s.v.Equal(edwards25519.NewScalar()) == 1
+}
+
+// Bytes returns the 32-byte little-endian encoding of s.
+func (s Scalar) Bytes() []byte {
+	return s.v.Bytes()
+}
+
+// decodeScalar decodes a scalar from its 32-byte little-endian encoding. It
+// rejects encodings of values greater than or equal to the group order.
+func decodeScalar(b []byte) (Scalar, error) {
+	if len(b) != scalarByteSize {
+		return Scalar{}, fmt.Errorf("invalid length: %d", len(b))
+	}
+	v, err := edwards25519.NewScalar().SetCanonicalBytes(b)
+	if err != nil {
+		return Scalar{}, fmt.Errorf("non-canonical encoding")
+	}
+	return makeScalar(v), nil
+}
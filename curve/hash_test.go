@@ -0,0 +1,122 @@
+package curve_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve/p256"
+	"github.com/matthiasgeihs/go-curve/curve/p384"
+	"github.com/matthiasgeihs/go-curve/curve/p521"
+	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
+)
+
+type hashToPointVector struct {
+	msg  string
+	x, y string
+}
+
+// Test vectors from Appendix J of RFC 9380.
+
+func TestHashToPoint_secp256k1(t *testing.T) {
+	testHashToPoint[secp256k1.Curve](t,
+		secp256k1.NewGenerator(),
+		"QUUX-V01-CS02-with-secp256k1_XMD:SHA-256_SSWU_RO_",
+		[]hashToPointVector{
+			{
+				"",
+				"c1cae290e291aee617ebaef1be6d73861479c48b841eaba9b7b5852ddfeb1346",
+				"64fa678e07ae116126f08b022a94af6de15985c996c3a91b64c406a960e51067",
+			},
+			{
+				"abc",
+				"3377e01eab42db296b512293120c6cee72b6ecf9f9205760bd9ff11fb3cb2c4b",
+				"7f95890f33efebd1044d382a01b1bee0900fb6116f94688d487c6c7b9c8371f6",
+			},
+		},
+	)
+}
+
+func TestHashToPoint_edwards25519(t *testing.T) {
+	testHashToPoint[edwards25519.Curve](t,
+		edwards25519.NewGenerator(),
+		"QUUX-V01-CS02-with-edwards25519_XMD:SHA-512_ELL2_RO_",
+		[]hashToPointVector{
+			{
+				"",
+				"3c3da6925a3c3c268448dcabb47ccde5439559d9599646a8260e47b1e4822fc6",
+				"09a6c8561a0b22bef63124c588ce4c62ea83a3c899763af26d795302e115dc21",
+			},
+			{
+				"abc",
+				"608040b42285cc0d72cbb3985c6b04c935370c7361f4b7fbdb1ae7f8c1a8ecad",
+				"1a8395b88338f22e435bbd301183e7f20a5f9de643f11882fb237f88268a5531",
+			},
+		},
+	)
+}
+
+func TestHashToPoint_p256(t *testing.T) {
+	testHashToPoint[p256.Curve](t,
+		p256.NewGenerator(),
+		"QUUX-V01-CS02-with-P256_XMD:SHA-256_SSWU_RO_",
+		[]hashToPointVector{
+			{
+				"",
+				"2c15230b26dbc6fc9a37051158c95b79656e17a1a920b11394ca91c44247d3e4",
+				"8a7a74985cc5c776cdfe4b1f19884970453912e9d31528c060be9ab5c43e8415",
+			},
+			{
+				"abc",
+				"0bb8b87485551aa43ed54f009230450b492fead5f1cc91658775dac4a3388a0f",
+				"5c41b3d0731a27a7b14bc0bf0ccded2d8751f83493404c84a88e71ffd424212e",
+			},
+		},
+	)
+}
+
+func TestHashToPoint_p384(t *testing.T) {
+	testHashToPoint[p384.Curve](t,
+		p384.NewGenerator(),
+		"QUUX-V01-CS02-with-P384_XMD:SHA-384_SSWU_RO_",
+		[]hashToPointVector{
+			{
+				"",
+				"eb9fe1b4f4e14e7140803c1d99d0a93cd823d2b024040f9c067a8eca1f5a2eeac9ad604973527a356f3fa3aeff0e4d83",
+				"0c21708cff382b7f4643c07b105c2eaec2cead93a917d825601e63c8f21f6abd9abc22c93c2bed6f235954b25048bb1a",
+			},
+		},
+	)
+}
+
+func TestHashToPoint_p521(t *testing.T) {
+	testHashToPoint[p521.Curve](t,
+		p521.NewGenerator(),
+		"QUUX-V01-CS02-with-P521_XMD:SHA-512_SSWU_RO_",
+		[]hashToPointVector{
+			{
+				"",
+				"00fd767cebb2452030358d0e9cf907f525f50920c8f607889a6a35680727f64f4d66b161fafeb2654bea0d35086bec0a10b30b14adef3556ed9f7f1bc23cecc9c088",
+				"0169ba78d8d851e930680322596e39c78f4fe31b97e57629ef6460ddd68f8763fd7bd767a4e94a80d3d21a3c2ee98347e024fc73ee1c27166dc3fe5eeef782be411d",
+			},
+		},
+	)
+}
+
+func testHashToPoint[C curve.Curve](
+	t *testing.T,
+	g curve.Generator[C],
+	dst string,
+	vectors []hashToPointVector,
+) {
+	for _, v := range vectors {
+		p := g.HashToPoint([]byte(dst), []byte(v.msg))
+		if x := fmt.Sprintf("%0*x", len(v.x), p.X()); x != v.x {
+			t.Errorf("msg %q: got x = %s, want %s", v.msg, x, v.x)
+		}
+		if y := fmt.Sprintf("%0*x", len(v.y), p.Y()); y != v.y {
+			t.Errorf("msg %q: got y = %s, want %s", v.msg, y, v.y)
+		}
+	}
+}
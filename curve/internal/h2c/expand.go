@@ -0,0 +1,88 @@
+// h2c implements the building blocks for hashing to elliptic curves from
+// Faz-Hernandez et al., "Hashing to Elliptic Curves", RFC 9380.
+package h2c
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"math/big"
+)
+
+const maxDSTLength = 255
+
+var oversizeDSTPrefix = []byte("H2C-OVERSIZE-DST-")
+
+// ExpandMessageXMD implements expand_message_xmd from Section 5.3.1 of RFC
+// 9380. Domain separation tags longer than 255 bytes are hashed as described
+// in Section 5.3.3.
+func ExpandMessageXMD(newHash func() hash.Hash, msg, dst []byte, lenInBytes int) ([]byte, error) {
+	h := newHash()
+	bInBytes := h.Size()
+	sInBytes := h.BlockSize()
+
+	if len(dst) > maxDSTLength {
+		h.Write(oversizeDSTPrefix)
+		h.Write(dst)
+		dst = h.Sum(nil)
+		h.Reset()
+	}
+
+	ell := (lenInBytes + bInBytes - 1) / bInBytes
+	if ell > 255 || lenInBytes > 65535 {
+		return nil, fmt.Errorf("requested length too large")
+	}
+
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+	var lIBStr [2]byte
+	binary.BigEndian.PutUint16(lIBStr[:], uint16(lenInBytes))
+
+	// b_0 = H(Z_pad || msg || l_i_b_str || I2OSP(0, 1) || DST_prime)
+	h.Write(make([]byte, sInBytes))
+	h.Write(msg)
+	h.Write(lIBStr[:])
+	h.Write([]byte{0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	// b_1 = H(b_0 || I2OSP(1, 1) || DST_prime)
+	h.Reset()
+	h.Write(b0)
+	h.Write([]byte{1})
+	h.Write(dstPrime)
+	bi := h.Sum(nil)
+
+	uniformBytes := make([]byte, 0, ell*bInBytes)
+	uniformBytes = append(uniformBytes, bi...)
+	for i := 2; i <= ell; i++ {
+		// b_i = H(strxor(b_0, b_(i - 1)) || I2OSP(i, 1) || DST_prime)
+		for j := range bi {
+			bi[j] ^= b0[j]
+		}
+		h.Reset()
+		h.Write(bi)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		uniformBytes = append(uniformBytes, bi...)
+	}
+	return uniformBytes[:lenInBytes], nil
+}
+
+// HashToField implements hash_to_field from Section 5.2 of RFC 9380 for prime
+// fields of order p. It returns count field elements, each derived from l
+// bytes of expand_message_xmd output.
+func HashToField(newHash func() hash.Hash, msg, dst []byte, p *big.Int, count, l int) ([]*big.Int, error) {
+	uniformBytes, err := ExpandMessageXMD(newHash, msg, dst, count*l)
+	if err != nil {
+		return nil, fmt.Errorf("expanding message: %w", err)
+	}
+
+	u := make([]*big.Int, count)
+	for i := range u {
+		tv := uniformBytes[i*l : (i+1)*l]
+		u[i] = new(big.Int).SetBytes(tv)
+		u[i].Mod(u[i], p)
+	}
+	return u, nil
+}
@@ -0,0 +1,51 @@
+package h2c_test
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"hash"
+	"testing"
+
+	"github.com/matthiasgeihs/go-curve/curve/internal/h2c"
+)
+
+// Test vectors from Appendix K.1 of RFC 9380.
+func TestExpandMessageXMD(t *testing.T) {
+	const dst = "QUUX-V01-CS02-with-expander-SHA256-128"
+	tests := []struct {
+		h   func() hash.Hash
+		msg string
+		len int
+		out string
+	}{
+		{sha256.New, "", 0x20, "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235"},
+		{sha256.New, "abc", 0x20, "d8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615"},
+	}
+	for _, tt := range tests {
+		out, err := h2c.ExpandMessageXMD(tt.h, []byte(tt.msg), []byte(dst), tt.len)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := hex.EncodeToString(out); got != tt.out {
+			t.Errorf("msg %q: got %s, want %s", tt.msg, got, tt.out)
+		}
+	}
+}
+
+func TestExpandMessageXMD_length(t *testing.T) {
+	for _, l := range []int{1, 32, 63, 64, 65, 128, 255} {
+		out, err := h2c.ExpandMessageXMD(sha512.New, []byte("msg"), []byte("dst"), l)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(out) != l {
+			t.Errorf("got %d bytes, want %d", len(out), l)
+		}
+	}
+
+	_, err := h2c.ExpandMessageXMD(sha256.New, nil, []byte("dst"), 256*32)
+	if err == nil {
+		t.Error("should reject oversized output length")
+	}
+}
@@ -0,0 +1,81 @@
+package h2c
+
+import "math/big"
+
+// Weierstrass describes a curve y^2 = x^3 + A*x + B over the prime field of
+// order P together with the constant Z of the simplified SWU map.
+type Weierstrass struct {
+	P, A, B, Z *big.Int
+}
+
+// MapToCurveSSWU implements the simplified Shallue-van de Woestijne-Ulas
+// method from Section 6.6.2 of RFC 9380. It requires A and B to be non-zero.
+func (c Weierstrass) MapToCurveSSWU(u *big.Int) (x, y *big.Int) {
+	p := c.P
+	zu2 := mul(p, c.Z, mul(p, u, u))
+
+	// tv1 = inv0(Z^2 * u^4 + Z * u^2)
+	tv1 := add(p, mul(p, zu2, zu2), zu2)
+	tv1 = inv0(p, tv1)
+
+	// x1 = (-B / A) * (1 + tv1), or B / (Z * A) if tv1 == 0.
+	var x1 *big.Int
+	if tv1.Sign() == 0 {
+		x1 = mul(p, c.B, inv0(p, mul(p, c.Z, c.A)))
+	} else {
+		x1 = mul(p, neg(p, c.B), inv0(p, c.A))
+		x1 = mul(p, x1, add(p, big.NewInt(1), tv1))
+	}
+	gx1 := c.rhs(x1)
+
+	// x2 = Z * u^2 * x1
+	x2 := mul(p, zu2, x1)
+	gx2 := c.rhs(x2)
+
+	if y1 := new(big.Int).ModSqrt(gx1, p); y1 != nil {
+		x, y = x1, y1
+	} else {
+		x, y = x2, new(big.Int).ModSqrt(gx2, p)
+	}
+
+	if Sgn0(u) != Sgn0(y) {
+		y = neg(p, y)
+	}
+	return x, y
+}
+
+// rhs computes x^3 + A*x + B.
+func (c Weierstrass) rhs(x *big.Int) *big.Int {
+	p := c.P
+	x3 := mul(p, mul(p, x, x), x)
+	return add(p, add(p, x3, mul(p, c.A, x)), c.B)
+}
+
+// Sgn0 implements sgn0 from Section 4.1 of RFC 9380 for prime fields.
+func Sgn0(x *big.Int) uint {
+	return x.Bit(0)
+}
+
+func add(p, a, b *big.Int) *big.Int {
+	r := new(big.Int).Add(a, b)
+	return r.Mod(r, p)
+}
+
+func mul(p, a, b *big.Int) *big.Int {
+	r := new(big.Int).Mul(a, b)
+	return r.Mod(r, p)
+}
+
+func neg(p, a *big.Int) *big.Int {
+	r := new(big.Int).Neg(a)
+	return r.Mod(r, p)
+}
+
+// inv0 computes the inverse of a, mapping zero to zero.
+func inv0(p, a *big.Int) *big.Int {
+	r := new(big.Int).ModInverse(a, p)
+	if r == nil {
+		return new(big.Int)
+	}
+	return r
+}
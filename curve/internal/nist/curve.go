@@ -0,0 +1,219 @@
+// nist implements the prime order NIST curves on top of filippo.io/nistec. It
+// is shared by the p256, p384 and p521 packages, which instantiate it with
+// their own curve type and the respective nistec point type.
+package nist
+
+import (
+	"crypto/elliptic"
+	"fmt"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/internal/h2c"
+)
+
+type Curve[C curve.Curve, P nistecPoint[P]] struct {
+	params   *elliptic.CurveParams
+	newPoint func() P
+	scalars  *modN
+	encoder  curve.Encoder[C]
+	suite    Suite
+}
+
+// Suite holds the parameters of the RFC 9380 hash-to-curve suite
+// Px_XMD:SHA-x_SSWU_RO_ of a curve.
+type Suite struct {
+	// Hash is the hash function used by expand_message_xmd.
+	Hash func() hash.Hash
+	// Z is the constant of the simplified SWU map.
+	Z int64
+	// L is the number of bytes hashed into a field element or scalar.
+	L int
+}
+
+// NewCurve returns the curve with the given parameters, whose points are
+// allocated by newPoint, such as nistec.NewP256Point.
+func NewCurve[C curve.Curve, P nistecPoint[P]](
+	params *elliptic.CurveParams,
+	newPoint func() P,
+	suite Suite,
+) Curve[C, P] {
+	c := Curve[C, P]{
+		params:   params,
+		newPoint: newPoint,
+		scalars:  newModN(params.N),
+		suite:    suite,
+	}
+	fieldSize := uint((params.BitSize + 7) / 8)
+	maxMessageLength := fieldSize / 2
+	c.encoder = curve.NewEncoder(
+		fieldSize,
+		params.P,
+		maxMessageLength,
+		func(i *big.Int) (curve.Point[C], error) {
+			return c.makePointFromAffineX(i)
+		},
+	)
+	return c
+}
+
+// NewPoint returns the point with affine coordinates (x, y), where (0, 0)
+// denotes the point at infinity. It panics if (x, y) is not on the curve.
+func (c Curve[C, P]) NewPoint(x, y *big.Int) curve.Point[C] {
+	if x.Sign() == 0 && y.Sign() == 0 {
+		return c.Identity()
+	}
+	l := c.fieldSize()
+	if x.Sign() < 0 || y.Sign() < 0 || x.BitLen() > 8*l || y.BitLen() > 8*l {
+		panic("point not on curve")
+	}
+	b := make([]byte, 1+2*l)
+	b[0] = sec1Uncompressed
+	x.FillBytes(b[1 : 1+l])
+	y.FillBytes(b[1+l:])
+	p, err := c.newPoint().SetBytes(b)
+	if err != nil {
+		panic(fmt.Sprintf("point not on curve: %v", err))
+	}
+	return c.wrap(p)
+}
+
+func (c Curve[C, P]) DecodePoint(b []byte) (curve.Point[C], error) {
+	p, err := decodePoint[C](c.newPoint, b)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (c Curve[C, P]) Generator() curve.Point[C] {
+	return c.wrap(c.newPoint().SetGenerator())
+}
+
+// MulBase returns s times the generator using the precomputed tables of
+// filippo.io/nistec.
+func (c Curve[C, P]) MulBase(s curve.Scalar[C]) curve.Point[C] {
+	p, err := c.newPoint().ScalarBaseMult(s.(Scalar[C]).Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return c.wrap(p)
+}
+
+func (c Curve[C, P]) Identity() curve.Point[C] {
+	return c.wrap(c.newPoint())
+}
+
+// Name returns the standard name of the curve, such as "P-256".
+func (c Curve[C, P]) Name() string {
+	return c.params.Name
+}
+
+func (c Curve[C, P]) GeneratorOrder() *big.Int {
+	return new(big.Int).Set(c.params.N)
+}
+
+// RandomScalar samples a uniformly random non-zero scalar by rejection
+// sampling.
+func (c Curve[C, P]) RandomScalar(rand io.Reader) (curve.Scalar[C], error) {
+	buf := make([]byte, c.scalars.size)
+	excessBits := len(buf)*8 - c.params.N.BitLen()
+	for {
+		_, err := io.ReadFull(rand, buf)
+		if err != nil {
+			return nil, err
+		}
+
+		// Clear the bits exceeding the bit length of the group order.
+		buf[0] &= 0xff >> excessBits
+		s, err := decodeScalar[C](c.scalars, buf)
+		if err == nil && !s.IsZero() {
+			return s, nil
+		}
+	}
+}
+
+func (c Curve[C, P]) NewScalar(v *big.Int) curve.Scalar[C] {
+	return makeScalar[C](c.scalars, v)
+}
+
+func (c Curve[C, P]) Zero() curve.Scalar[C] {
+	return makeScalar[C](c.scalars, new(big.Int))
+}
+
+func (c Curve[C, P]) One() curve.Scalar[C] {
+	return makeScalar[C](c.scalars, big.NewInt(1))
+}
+
+func (c Curve[C, P]) DecodeScalar(b []byte) (curve.Scalar[C], error) {
+	s, err := decodeScalar[C](c.scalars, b)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// HashToScalar hashes msg to a scalar using hash_to_field from RFC 9380 with
+// the curve's expand_message_xmd parameters and domain separation tag dst.
+func (c Curve[C, P]) HashToScalar(dst, msg []byte) curve.Scalar[C] {
+	u, err := h2c.HashToField(c.suite.Hash, msg, dst, c.params.N, 1, c.suite.L)
+	if err != nil {
+		panic(err)
+	}
+	return makeScalar[C](c.scalars, u[0])
+}
+
+func (c Curve[C, P]) EncodeToPoint(data []byte) (curve.Point[C], error) {
+	return c.encoder.EncodeToPoint(data)
+}
+
+func (c Curve[C, P]) DecodeFromPoint(p curve.Point[C]) []byte {
+	return c.encoder.DecodeFromPoint(p)
+}
+
+// HashToPoint hashes msg to a point using the curve's RFC 9380 suite with
+// domain separation tag dst.
+func (c Curve[C, P]) HashToPoint(dst, msg []byte) curve.Point[C] {
+	params := c.params
+	u, err := h2c.HashToField(c.suite.Hash, msg, dst, params.P, 2, c.suite.L)
+	if err != nil {
+		panic(err)
+	}
+
+	sswu := h2c.Weierstrass{
+		P: params.P,
+		A: new(big.Int).Sub(params.P, big.NewInt(3)),
+		B: params.B,
+		Z: new(big.Int).Add(params.P, big.NewInt(c.suite.Z)),
+	}
+	x0, y0 := sswu.MapToCurveSSWU(u[0])
+	x1, y1 := sswu.MapToCurveSSWU(u[1])
+	return c.NewPoint(x0, y0).Add(c.NewPoint(x1, y1))
+}
+
+// makePointFromAffineX computes a point from an x-coordinate.
+func (c Curve[C, P]) makePointFromAffineX(x *big.Int) (curve.Point[C], error) {
+	xMod := new(big.Int).Mod(x, c.params.P)
+	b := make([]byte, 1+c.fieldSize())
+	b[0] = sec1Compressed0
+	xMod.FillBytes(b[1:])
+	p, err := c.newPoint().SetBytes(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute square root")
+	}
+	return c.wrap(p), nil
+}
+
+func (c Curve[C, P]) wrap(p P) Point[C, P] {
+	return Point[C, P]{
+		newPoint: c.newPoint,
+		p:        p,
+	}
+}
+
+// fieldSize returns the byte length of field elements.
+func (c Curve[C, P]) fieldSize() int {
+	return (c.params.BitSize + 7) / 8
+}
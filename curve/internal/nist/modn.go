@@ -0,0 +1,213 @@
+package nist
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// This file implements arithmetic modulo the group order on fixed-size
+// little-endian 64-bit limbs. Values are kept in Montgomery form aR mod n with
+// R = 2^(64*k) for k limbs. All operations run in constant time with respect to
+// their operands, in contrast to math/big.
+
+// modN holds the precomputed Montgomery parameters of a group order n.
+type modN struct {
+	n     *big.Int
+	limbs []uint64
+	// nInv is -n^-1 mod 2^64.
+	nInv uint64
+	// rr is R^2 mod n, used to convert into Montgomery form.
+	rr []uint64
+	// size is the byte length of n.
+	size int
+}
+
+func newModN(n *big.Int) *modN {
+	k := (n.BitLen() + 63) / 64
+	r := new(big.Int).Lsh(big.NewInt(1), uint(64*k))
+	rr := new(big.Int).Mul(r, r)
+	rr.Mod(rr, n)
+
+	word := new(big.Int).Lsh(big.NewInt(1), 64)
+	nInv := new(big.Int).ModInverse(new(big.Int).Mod(n, word), word)
+	nInv.Sub(word, nInv)
+
+	m := &modN{
+		n:     n,
+		limbs: make([]uint64, k),
+		nInv:  nInv.Uint64(),
+		rr:    make([]uint64, k),
+		size:  (n.BitLen() + 7) / 8,
+	}
+	m.setInt(m.limbs, n)
+	m.setInt(m.rr, rr)
+	return m
+}
+
+// setInt sets z to the limbs of the non-negative integer v < R.
+func (m *modN) setInt(z []uint64, v *big.Int) {
+	b := v.FillBytes(make([]byte, 8*len(z)))
+	m.setBytes(z, b)
+}
+
+// setBytes sets z to the limbs of the big-endian integer b, which is at
+// most 8*len(z) bytes long.
+func (m *modN) setBytes(z []uint64, b []byte) {
+	for i := range z {
+		z[i] = 0
+	}
+	for i, v := range b {
+		shift := len(b) - 1 - i
+		z[shift/8] |= uint64(v) << (8 * (shift % 8))
+	}
+}
+
+// fromBytes converts the big-endian encoding b of a value less than n into
+// Montgomery form. It reports whether b is canonical, in which case the result
+// is valid.
+func (m *modN) fromBytes(b []byte) ([]uint64, bool) {
+	if len(b) != m.size {
+		return nil, false
+	}
+	z := make([]uint64, len(m.limbs))
+	m.setBytes(z, b)
+	borrow := sub(make([]uint64, len(z)), z, m.limbs)
+	m.montMul(z, z, m.rr)
+	return z, borrow == 1
+}
+
+// fromInt converts v into Montgomery form, reducing it modulo n. The
+// reduction uses math/big and is meant for public values.
+func (m *modN) fromInt(v *big.Int) []uint64 {
+	z := make([]uint64, len(m.limbs))
+	m.setInt(z, new(big.Int).Mod(v, m.n))
+	m.montMul(z, z, m.rr)
+	return z
+}
+
+// bytes returns the big-endian encoding of x, padded to the byte length of n.
+func (m *modN) bytes(x []uint64) []byte {
+	one := make([]uint64, len(x))
+	one[0] = 1
+	z := make([]uint64, len(x))
+	m.montMul(z, x, one)
+
+	b := make([]byte, m.size)
+	for i := range b {
+		shift := len(b) - 1 - i
+		b[i] = byte(z[shift/8] >> (8 * (shift % 8)))
+	}
+	return b
+}
+
+// add sets z = x + y mod n.
+func (m *modN) add(z, x, y []uint64) {
+	var carry uint64
+	for i := range z {
+		z[i], carry = bits.Add64(x[i], y[i], carry)
+	}
+	m.reduceOnce(z, carry)
+}
+
+// sub sets z = x - y mod n.
+func (m *modN) sub(z, x, y []uint64) {
+	borrow := sub(z, x, y)
+	mask := -borrow
+	var carry uint64
+	for i := range z {
+		z[i], carry = bits.Add64(z[i], m.limbs[i]&mask, carry)
+	}
+}
+
+// montMul sets z = x * y / R mod n using coarsely integrated operand scanning
+// (CIOS). z may alias x or y.
+func (m *modN) montMul(z, x, y []uint64) {
+	k := len(m.limbs)
+	t := make([]uint64, k+2)
+	for i := 0; i < k; i++ {
+		// t += x * y[i]
+		var c uint64
+		for j := 0; j < k; j++ {
+			hi, lo := bits.Mul64(x[j], y[i])
+			var cc uint64
+			lo, cc = bits.Add64(lo, t[j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			t[j], c = lo, hi
+		}
+		t[k], c = bits.Add64(t[k], c, 0)
+		t[k+1] = c
+
+		// t = (t + u*n) / 2^64, where u is chosen such that the division is
+		// exact.
+		u := t[0] * m.nInv
+		hi, lo := bits.Mul64(u, m.limbs[0])
+		_, cc := bits.Add64(lo, t[0], 0)
+		c = hi + cc
+		for j := 1; j < k; j++ {
+			hi, lo = bits.Mul64(u, m.limbs[j])
+			lo, cc = bits.Add64(lo, t[j], 0)
+			hi += cc
+			lo, cc = bits.Add64(lo, c, 0)
+			hi += cc
+			t[j-1], c = lo, hi
+		}
+		t[k-1], c = bits.Add64(t[k], c, 0)
+		t[k] = t[k+1] + c
+	}
+	copy(z, t[:k])
+	m.reduceOnce(z, t[k])
+}
+
+// reduceOnce subtracts n from the value (hi, z) if it is at least n, given
+// that it is less than 2n.
+func (m *modN) reduceOnce(z []uint64, hi uint64) {
+	d := make([]uint64, len(z))
+	borrow := sub(d, z, m.limbs)
+	// Keep z only if it is less than n, that is, if the subtraction borrowed
+	// and there is no high word.
+	keep := borrow &^ hi
+	mask := -keep
+	for i := range z {
+		z[i] = z[i]&mask | d[i]&^mask
+	}
+}
+
+// inv sets z = x^-1 mod n as x^(n-2), which maps zero to zero.
+func (m *modN) inv(z, x []uint64) {
+	e := new(big.Int).Sub(m.n, big.NewInt(2))
+	r := m.fromInt(big.NewInt(1))
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		m.montMul(r, r, r)
+		// The exponent is public, hence branching on its bits is safe.
+		if e.Bit(i) == 1 {
+			m.montMul(r, r, x)
+		}
+	}
+	copy(z, r)
+}
+
+// equal reports whether x == y.
+func equal(x, y []uint64) bool {
+	var d uint64
+	for i := range x {
+		d |= x[i] ^ y[i]
+	}
+	// (d | -d) has its top bit set if and only if d is non-zero.
+	return (d|-d)>>63 == 0
+}
+
+// isZero reports whether x == 0.
+func isZero(x []uint64) bool {
+	return equal(x, make([]uint64, len(x)))
+}
+
+// sub sets z = x - y and returns the final borrow.
+func sub(z, x, y []uint64) uint64 {
+	var borrow uint64
+	for i := range z {
+		z[i], borrow = bits.Sub64(x[i], y[i], borrow)
+	}
+	return borrow
+}
@@ -0,0 +1,100 @@
+package nist_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/p256"
+	"github.com/matthiasgeihs/go-curve/curve/p384"
+	"github.com/matthiasgeihs/go-curve/curve/p521"
+)
+
+func TestScalar_p256(t *testing.T) {
+	testScalar[p256.Curve](t, p256.NewGenerator())
+}
+
+func TestScalar_p384(t *testing.T) {
+	testScalar[p384.Curve](t, p384.NewGenerator())
+}
+
+func TestScalar_p521(t *testing.T) {
+	testScalar[p521.Curve](t, p521.NewGenerator())
+}
+
+// testScalar compares the scalar arithmetic with math/big.
+func testScalar[C curve.Curve](t *testing.T, g curve.Generator[C]) {
+	n := g.GeneratorOrder()
+	values := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(1),
+		big.NewInt(2),
+		new(big.Int).Sub(n, big.NewInt(1)),
+		new(big.Int).Sub(n, big.NewInt(2)),
+	}
+	for i := 0; i < 20; i++ {
+		v, err := rand.Int(rand.Reader, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values = append(values, v)
+	}
+
+	mod := func(v *big.Int) *big.Int {
+		return v.Mod(v, n)
+	}
+	for _, a := range values {
+		sa := g.NewScalar(a)
+		if sa.Int().Cmp(a) != 0 {
+			t.Errorf("Int() = %v, want %v", sa.Int(), a)
+		}
+		if !bytes.Equal(sa.Bytes(), a.FillBytes(make([]byte, len(sa.Bytes())))) {
+			t.Errorf("Bytes() of %v incorrect", a)
+		}
+		if sa.IsZero() != (a.Sign() == 0) {
+			t.Errorf("IsZero() of %v incorrect", a)
+		}
+		if got, want := sa.Neg().Int(), mod(new(big.Int).Neg(a)); got.Cmp(want) != 0 {
+			t.Errorf("-%v = %v, want %v", a, got, want)
+		}
+		want := new(big.Int).ModInverse(a, n)
+		if want == nil {
+			want = new(big.Int)
+		}
+		if got := sa.Inv().Int(); got.Cmp(want) != 0 {
+			t.Errorf("%v^-1 = %v, want %v", a, got, want)
+		}
+		for _, b := range values {
+			sb := g.NewScalar(b)
+			if got, want := sa.Add(sb).Int(), mod(new(big.Int).Add(a, b)); got.Cmp(want) != 0 {
+				t.Errorf("%v + %v = %v, want %v", a, b, got, want)
+			}
+			if got, want := sa.Sub(sb).Int(), mod(new(big.Int).Sub(a, b)); got.Cmp(want) != 0 {
+				t.Errorf("%v - %v = %v, want %v", a, b, got, want)
+			}
+			if got, want := sa.Mul(sb).Int(), mod(new(big.Int).Mul(a, b)); got.Cmp(want) != 0 {
+				t.Errorf("%v * %v = %v, want %v", a, b, got, want)
+			}
+			if sa.Equal(sb) != (a.Cmp(b) == 0) {
+				t.Errorf("Equal(%v, %v) incorrect", a, b)
+			}
+		}
+	}
+}
+
+func TestNewPoint_p256(t *testing.T) {
+	g := p256.NewGenerator()
+	p := g.Generator()
+	q := g.NewPoint(p.X(), p.Y())
+	if q.X().Cmp(p.X()) != 0 || q.Y().Cmp(p.Y()) != 0 {
+		t.Error("point from coordinates of generator should equal generator")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("point not on curve should be rejected")
+		}
+	}()
+	g.NewPoint(p.X(), new(big.Int).Add(p.Y(), big.NewInt(1)))
+}
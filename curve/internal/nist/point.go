@@ -0,0 +1,137 @@
+package nist
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+)
+
+// Format bytes of SEC1 point encodings.
+const (
+	sec1Infinity     = 0x00
+	sec1Compressed0  = 0x02
+	sec1Compressed1  = 0x03
+	sec1Uncompressed = 0x04
+)
+
+// nistecPoint is implemented by the point types of filippo.io/nistec, such as
+// *nistec.P256Point. Their methods set the receiver to the result and return
+// it.
+type nistecPoint[P any] interface {
+	SetGenerator() P
+	SetBytes([]byte) (P, error)
+	Bytes() []byte
+	BytesCompressed() []byte
+	Add(P, P) P
+	Double(P) P
+	Negate(P) P
+	ScalarMult(P, []byte) (P, error)
+	ScalarBaseMult([]byte) (P, error)
+}
+
+// Point wraps a point of filippo.io/nistec, which can only represent points on
+// the curve. The point at infinity is the identity.
+type Point[C curve.Curve, P nistecPoint[P]] struct {
+	newPoint func() P
+	p        P
+}
+
+func (p Point[C, P]) wrap(q P) Point[C, P] {
+	return Point[C, P]{
+		newPoint: p.newPoint,
+		p:        q,
+	}
+}
+
+// X returns the affine x-coordinate of p, or 0 for the point at infinity.
+func (p Point[C, P]) X() *big.Int {
+	x, _ := p.affine()
+	return x
+}
+
+// Y returns the affine y-coordinate of p, or 0 for the point at infinity.
+func (p Point[C, P]) Y() *big.Int {
+	_, y := p.affine()
+	return y
+}
+
+func (p Point[C, P]) affine() (*big.Int, *big.Int) {
+	b := p.p.Bytes()
+	if len(b) == 1 {
+		return new(big.Int), new(big.Int)
+	}
+	l := (len(b) - 1) / 2
+	return new(big.Int).SetBytes(b[1 : 1+l]), new(big.Int).SetBytes(b[1+l:])
+}
+
+func (p Point[C, P]) Add(q curve.Point[C]) curve.Point[C] {
+	return p.wrap(p.newPoint().Add(p.p, q.(Point[C, P]).p))
+}
+
+// Mul returns s*p. The scalar multiplication of filippo.io/nistec runs in
+// constant time.
+func (p Point[C, P]) Mul(s curve.Scalar[C]) curve.Point[C] {
+	r, err := p.newPoint().ScalarMult(p.p, s.(Scalar[C]).Bytes())
+	if err != nil {
+		panic(err)
+	}
+	return p.wrap(r)
+}
+
+func (p Point[C, P]) Equal(q curve.Point[C]) bool {
+	return bytes.Equal(p.p.Bytes(), q.(Point[C, P]).p.Bytes())
+}
+
+func (p Point[C, P]) Neg() curve.Point[C] {
+	return p.wrap(p.newPoint().Negate(p.p))
+}
+
+func (p Point[C, P]) Sub(q curve.Point[C]) curve.Point[C] {
+	return p.Add(q.Neg())
+}
+
+func (p Point[C, P]) Double() curve.Point[C] {
+	return p.wrap(p.newPoint().Double(p.p))
+}
+
+func (p Point[C, P]) IsIdentity() bool {
+	return len(p.p.Bytes()) == 1
+}
+
+// Bytes returns the uncompressed SEC1 encoding of p. The point at infinity is
+// encoded as a single zero byte.
+func (p Point[C, P]) Bytes() []byte {
+	return p.p.Bytes()
+}
+
+// CompressedBytes returns the compressed SEC1 encoding of p. The point at
+// infinity is encoded as a single zero byte.
+func (p Point[C, P]) CompressedBytes() []byte {
+	return p.p.BytesCompressed()
+}
+
+// decodePoint decodes a point from its compressed or uncompressed SEC1
+// encoding. It rejects the point at infinity and, as filippo.io/nistec checks
+// the curve equation, points not on the curve.
+func decodePoint[C curve.Curve, P nistecPoint[P]](newPoint func() P, b []byte) (Point[C, P], error) {
+	if len(b) == 0 {
+		return Point[C, P]{}, fmt.Errorf("empty encoding")
+	}
+	switch b[0] {
+	case sec1Compressed0, sec1Compressed1, sec1Uncompressed:
+	case sec1Infinity:
+		return Point[C, P]{}, fmt.Errorf("point at infinity")
+	default:
+		return Point[C, P]{}, fmt.Errorf("invalid format: %#x", b[0])
+	}
+	p, err := newPoint().SetBytes(b)
+	if err != nil {
+		return Point[C, P]{}, fmt.Errorf("invalid encoding: %w", err)
+	}
+	return Point[C, P]{
+		newPoint: newPoint,
+		p:        p,
+	}, nil
+}
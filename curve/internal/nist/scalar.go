@@ -0,0 +1,101 @@
+package nist
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+)
+
+// Scalar is an integer modulo the order n of the curve's generator. Its
+// arithmetic runs in constant time.
+type Scalar[C curve.Curve] struct {
+	m *modN
+	v []uint64
+}
+
+func makeScalar[C curve.Curve](m *modN, v *big.Int) Scalar[C] {
+	return Scalar[C]{
+		m: m,
+		v: m.fromInt(v),
+	}
+}
+
+func (s Scalar[C]) with(v []uint64) Scalar[C] {
+	return Scalar[C]{
+		m: s.m,
+		v: v,
+	}
+}
+
+// Bytes returns the big-endian encoding of s padded to the byte length of the
+// generator order.
+func (s Scalar[C]) Bytes() []byte {
+	return s.m.bytes(s.v)
+}
+
+// decodeScalar decodes a scalar from its big-endian encoding. It rejects
+// encodings of values greater than or equal to the group order.
+func decodeScalar[C curve.Curve](m *modN, b []byte) (Scalar[C], error) {
+	if len(b) != m.size {
+		return Scalar[C]{}, fmt.Errorf("invalid length: %d", len(b))
+	}
+	v, ok := m.fromBytes(b)
+	if !ok {
+		return Scalar[C]{}, fmt.Errorf("non-canonical encoding")
+	}
+	return Scalar[C]{
+		m: m,
+		v: v,
+	}, nil
+}
+
+// Inv returns the inverse of s, computed in constant time. Zero is mapped to
+// zero, following the other backends.
+func (s Scalar[C]) Inv() curve.Scalar[C] {
+	z := make([]uint64, len(s.v))
+	s.m.inv(z, s.v)
+	return s.with(z)
+}
+
+// InvConstTime returns the inverse of s. It is equivalent to Inv, which
+// already runs in constant time.
+func (s Scalar[C]) InvConstTime() curve.Scalar[C] {
+	return s.Inv()
+}
+
+func (s Scalar[C]) Add(t curve.Scalar[C]) curve.Scalar[C] {
+	z := make([]uint64, len(s.v))
+	s.m.add(z, s.v, t.(Scalar[C]).v)
+	return s.with(z)
+}
+
+func (s Scalar[C]) Sub(t curve.Scalar[C]) curve.Scalar[C] {
+	z := make([]uint64, len(s.v))
+	s.m.sub(z, s.v, t.(Scalar[C]).v)
+	return s.with(z)
+}
+
+func (s Scalar[C]) Mul(t curve.Scalar[C]) curve.Scalar[C] {
+	z := make([]uint64, len(s.v))
+	s.m.montMul(z, s.v, t.(Scalar[C]).v)
+	return s.with(z)
+}
+
+func (s Scalar[C]) Int() *big.Int {
+	return new(big.Int).SetBytes(s.Bytes())
+}
+
+func (s Scalar[C]) Equal(t curve.Scalar[C]) bool {
+	return equal(s.v, t.(Scalar[C]).v)
+}
+
+func (s Scalar[C]) Neg() curve.Scalar[C] {
+	z := make([]uint64, len(s.v))
+	s.m.sub(z, z, s.v)
+	return s.with(z)
+}
+
+func (s Scalar[C]) IsZero() bool {
+	return isZero(s.v)
+}
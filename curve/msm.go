@@ -0,0 +1,132 @@
+package curve
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// MultiScalarMulPoint is implemented by points that provide a backend specific
+// multi-scalar multiplication. The receiver is not part of the computation.
+type MultiScalarMulPoint[C Curve] interface {
+	MultiScalarMul(points []Point[C], scalars []Scalar[C]) Point[C]
+}
+
+// strausThreshold is the number of terms up to which Straus' method is used.
+// Pippenger's bucket method is used for larger inputs.
+const strausThreshold = 64
+
+// strausWindowSize is the bit width of the windows used by Straus' method.
+const strausWindowSize = 4
+
+// MultiScalarMul returns the sum of scalars[i]*points[i]. It runs in variable
+// time and must only be used with public inputs, as in verification
+// equations. It uses the fast path of the backend if points[0] implements
+// MultiScalarMulPoint, and Straus' or Pippenger's method otherwise. It panics
+// if the slices are empty or differ in length.
+func MultiScalarMul[C Curve](points []Point[C], scalars []Scalar[C]) Point[C] {
+	if len(points) != len(scalars) {
+		panic("number of points and scalars differ")
+	}
+	if len(points) == 0 {
+		panic("no points given")
+	}
+
+	if msm, ok := points[0].(MultiScalarMulPoint[C]); ok {
+		return msm.MultiScalarMul(points, scalars)
+	}
+
+	ks := make([]*big.Int, len(scalars))
+	for i, s := range scalars {
+		ks[i] = s.Int()
+	}
+	if len(points) <= strausThreshold {
+		return straus(points, ks)
+	}
+	return pippenger(points, ks)
+}
+
+// straus computes the multi-scalar multiplication using interleaved fixed
+// windows, sharing the doublings between all terms.
+func straus[C Curve](points []Point[C], ks []*big.Int) Point[C] {
+	const tableSize = 1 << strausWindowSize
+	id := points[0].Sub(points[0])
+
+	tables := make([][tableSize]Point[C], len(points))
+	for i, p := range points {
+		tables[i][0] = id
+		for j := 1; j < tableSize; j++ {
+			tables[i][j] = tables[i][j-1].Add(p)
+		}
+	}
+
+	windows := (maxBitLen(ks) + strausWindowSize - 1) / strausWindowSize
+	acc := id
+	for w := windows - 1; w >= 0; w-- {
+		for j := 0; j < strausWindowSize; j++ {
+			acc = acc.Double()
+		}
+		for i, k := range ks {
+			if d := digit(k, w*strausWindowSize, strausWindowSize); d != 0 {
+				acc = acc.Add(tables[i][d])
+			}
+		}
+	}
+	return acc
+}
+
+// pippenger computes the multi-scalar multiplication using the bucket method.
+func pippenger[C Curve](points []Point[C], ks []*big.Int) Point[C] {
+	// A window size of about log2(n) balances the bucket accumulation against
+	// the bucket aggregation.
+	c := bits.Len(uint(len(points))) - 2
+	if c < 1 {
+		c = 1
+	}
+	id := points[0].Sub(points[0])
+
+	windows := (maxBitLen(ks) + c - 1) / c
+	buckets := make([]Point[C], 1<<c-1)
+	acc := id
+	for w := windows - 1; w >= 0; w-- {
+		for j := 0; j < c; j++ {
+			acc = acc.Double()
+		}
+
+		for j := range buckets {
+			buckets[j] = id
+		}
+		for i, k := range ks {
+			if d := digit(k, w*c, c); d != 0 {
+				buckets[d-1] = buckets[d-1].Add(points[i])
+			}
+		}
+
+		// Compute sum_j j*buckets[j-1] using running sums.
+		sum, running := id, id
+		for j := len(buckets) - 1; j >= 0; j-- {
+			running = running.Add(buckets[j])
+			sum = sum.Add(running)
+		}
+		acc = acc.Add(sum)
+	}
+	return acc
+}
+
+func maxBitLen(ks []*big.Int) int {
+	n := 0
+	for _, k := range ks {
+		if l := k.BitLen(); l > n {
+			n = l
+		}
+	}
+	return n
+}
+
+// digit returns the n bits of k starting at bit i.
+func digit(k *big.Int, i, n int) uint {
+	var d uint
+	for j := n - 1; j >= 0; j-- {
+		d = d<<1 | k.Bit(i+j)
+	}
+	return d
+}
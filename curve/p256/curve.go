@@ -0,0 +1,37 @@
+package p256
+
+import (
+	"crypto/elliptic"
+	"crypto/sha256"
+
+	"filippo.io/nistec"
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/internal/nist"
+)
+
+// suite holds the parameters of P256_XMD:SHA-256_SSWU_RO_ from Section 8.2 of
+// RFC 9380.
+var suite = nist.Suite{
+	Hash: sha256.New,
+	Z:    -10,
+	L:    48,
+}
+
+type Curve struct {
+	nist.Curve[Curve, *nistec.P256Point]
+}
+
+type Point = nist.Point[Curve, *nistec.P256Point]
+type Scalar = nist.Scalar[Curve]
+
+// Check that type implements interface.
+var _ curve.Generator[Curve] = Curve{}
+var _ curve.Point[Curve] = Point{}
+var _ curve.Scalar[Curve] = Scalar{}
+var _ curve.ConstTimeScalar[Curve] = Scalar{}
+
+func NewGenerator() Curve {
+	return Curve{
+		Curve: nist.NewCurve[Curve](elliptic.P256().Params(), nistec.NewP256Point, suite),
+	}
+}
@@ -0,0 +1,37 @@
+package p521
+
+import (
+	"crypto/elliptic"
+	"crypto/sha512"
+
+	"filippo.io/nistec"
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/internal/nist"
+)
+
+// suite holds the parameters of P521_XMD:SHA-512_SSWU_RO_ from Section 8.4 of
+// RFC 9380.
+var suite = nist.Suite{
+	Hash: sha512.New,
+	Z:    -4,
+	L:    98,
+}
+
+type Curve struct {
+	nist.Curve[Curve, *nistec.P521Point]
+}
+
+type Point = nist.Point[Curve, *nistec.P521Point]
+type Scalar = nist.Scalar[Curve]
+
+// Check that type implements interface.
+var _ curve.Generator[Curve] = Curve{}
+var _ curve.Point[Curve] = Point{}
+var _ curve.Scalar[Curve] = Scalar{}
+var _ curve.ConstTimeScalar[Curve] = Scalar{}
+
+func NewGenerator() Curve {
+	return Curve{
+		Curve: nist.NewCurve[Curve](elliptic.P521().Params(), nistec.NewP521Point, suite),
+	}
+}
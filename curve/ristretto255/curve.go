@@ -0,0 +1,187 @@
+// ristretto255 implements the prime order group ristretto255 from RFC 9496 on
+// top of edwards25519.
+package ristretto255
+
+import (
+	"crypto/sha512"
+	"fmt"
+	"io"
+	"math/big"
+
+	"filippo.io/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/internal/h2c"
+)
+
+type Curve struct{}
+
+var generatorOrder, _ = new(big.Int).SetString("1000000000000000000000000000000014DEF9DEA2F79CD65812631A5CF5D3ED", 16)
+
+const scalarByteSize = 32
+const uniformScalarByteSize = 64
+
+// hashToFieldLength is the number of bytes hashed into a scalar, following
+// Section 5 of RFC 9380 for a security level of 128 bits.
+const hashToFieldLength = 48
+const elementSize = 32
+
+// Layout of the message embedding used by EncodeToPoint. The encoding is
+// `len(data) << 1 || data || 0... || counter || 0`, which keeps the encoded
+// field element non-negative and below the field order.
+const maxMessageLength = elementSize / 2
+const idxMessageLength = 0
+const idxMessageStart = 1
+const idxCounter = elementSize - 3
+const maxCounter = 1<<16 - 1
+
+// Check that type implements interface.
+var _ curve.Generator[Curve] = Curve{}
+
+func NewGenerator() Curve {
+	return Curve{}
+}
+
+// NewPoint decodes the point from the canonical encoding given by x,
+// interpreted as a little-endian integer. Ristretto255 elements have no affine
+// coordinates, so y is ignored.
+func (Curve) NewPoint(x, _ *big.Int) curve.Point[Curve] {
+	p, err := decodePoint(littleEndian(x, elementSize))
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// DecodePoint decodes an element from its canonical encoding. It rejects the
+// identity element.
+func (Curve) DecodePoint(b []byte) (curve.Point[Curve], error) {
+	p, err := decodePoint(b)
+	if err != nil {
+		return nil, err
+	}
+	if p.p.Equal(edwards25519.NewIdentityPoint()) == 1 {
+		return nil, fmt.Errorf("identity element")
+	}
+	return p, nil
+}
+
+func (Curve) Generator() curve.Point[Curve] {
+	g := edwards25519.NewGeneratorPoint()
+	return makePoint(g)
+}
+
+// MulBase returns s times the generator using the precomputed tables of the
+// edwards25519 package.
+func (Curve) MulBase(s curve.Scalar[Curve]) curve.Point[Curve] {
+	prod := new(edwards25519.Point).ScalarBaseMult(s.(Scalar).v)
+	return makePoint(prod)
+}
+
+func (Curve) Identity() curve.Point[Curve] {
+	return makePoint(edwards25519.NewIdentityPoint())
+}
+
+func (Curve) Name() string {
+	return "ristretto255"
+}
+
+func (Curve) GeneratorOrder() *big.Int {
+	return new(big.Int).Set(generatorOrder)
+}
+
+// RandomScalar samples a uniformly random non-zero scalar by reducing 64
+// random bytes modulo the group order.
+func (Curve) RandomScalar(rand io.Reader) (curve.Scalar[Curve], error) {
+	buf := make([]byte, uniformScalarByteSize)
+	for {
+		_, err := io.ReadFull(rand, buf)
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := edwards25519.NewScalar().SetUniformBytes(buf)
+		if err != nil {
+			return nil, err
+		}
+		if s := makeScalar(v); !s.IsZero() {
+			return s, nil
+		}
+	}
+}
+
+func (Curve) NewScalar(v *big.Int) curve.Scalar[Curve] {
+	return makeScalarFromBigInt(v)
+}
+
+func (Curve) Zero() curve.Scalar[Curve] {
+	return makeScalar(edwards25519.NewScalar())
+}
+
+func (Curve) One() curve.Scalar[Curve] {
+	return makeScalarFromBigInt(big.NewInt(1))
+}
+
+func (Curve) DecodeScalar(b []byte) (curve.Scalar[Curve], error) {
+	s, err := decodeScalar(b)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// HashToScalar hashes msg to a scalar using hash_to_field from RFC 9380 with
+// expand_message_xmd, SHA-512 and domain separation tag dst.
+func (Curve) HashToScalar(dst, msg []byte) curve.Scalar[Curve] {
+	u, err := h2c.HashToField(sha512.New, msg, dst, generatorOrder, 1, hashToFieldLength)
+	if err != nil {
+		panic(err)
+	}
+	return makeScalarFromBigInt(u[0])
+}
+
+// NewPointFromUniformBytes derives an element from 64 uniformly random bytes
+// as specified in Section 4.3.4 of RFC 9496.
+func (Curve) NewPointFromUniformBytes(b []byte) (curve.Point[Curve], error) {
+	return makePointFromUniformBytes(b)
+}
+
+// HashToPoint hashes msg to an element using hash_to_ristretto255 from
+// Appendix B of RFC 9380 with domain separation tag dst.
+func (Curve) HashToPoint(dst, msg []byte) curve.Point[Curve] {
+	b, err := h2c.ExpandMessageXMD(sha512.New, msg, dst, 2*elementSize)
+	if err != nil {
+		panic(err)
+	}
+	p, err := makePointFromUniformBytes(b)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func (Curve) EncodeToPoint(data []byte) (curve.Point[Curve], error) {
+	if len(data) > maxMessageLength {
+		return nil, fmt.Errorf("data exceeds message space")
+	}
+
+	buf := make([]byte, elementSize)
+	buf[idxMessageLength] = byte(len(data)) << 1
+	copy(buf[idxMessageStart:], data)
+	for counter := 0; counter <= maxCounter; counter++ {
+		buf[idxCounter] = byte(counter)
+		buf[idxCounter+1] = byte(counter >> 8)
+		p, err := decodePoint(buf)
+		if err == nil {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("failed to encode data")
+}
+
+func (Curve) DecodeFromPoint(p curve.Point[Curve]) []byte {
+	buf := p.(Point).bytes()
+	l := buf[idxMessageLength] >> 1
+	data := make([]byte, l)
+	copy(data, buf[idxMessageStart:])
+	return data
+}
@@ -0,0 +1,153 @@
+package ristretto255_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/ristretto255"
+)
+
+// Multiples of the generator from Appendix A.1 of RFC 9496.
+var generatorMultiples = []string{
+	"0000000000000000000000000000000000000000000000000000000000000000",
+	"e2f2ae0a6abc4e71a884a961c500515f58e30b6aa582dd8db6a65945e08d2d76",
+	"6a493210f7499cd17fecb510ae0cea23a110e8d5b901f8acadd3095c73a3b919",
+	"94741f5d5d52755ece4f23f044ee27d5d1ea1e2bd196b462166b16152a9d0259",
+	"da80862773358b466ffadfe0b3293ab3d9fd53c5ea6c955358f568322daf6a57",
+	"e882b131016b52c1d3337080187cf768423efccbb517bb495ab812c4160ff44e",
+	"f64746d3c92b13050ed8d80236a7f0007c3b3f962f5ba793d19a601ebb1df403",
+	"44f53520926ec81fbd5a387845beb7df85a96a24ece18738bdcfa6a7822a176d",
+	"903293d8f2287ebe10e2374dc1a53e0bc887e592699f02d077d5263cdd55601c",
+}
+
+func TestGeneratorMultiples(t *testing.T) {
+	g := ristretto255.NewGenerator()
+	for i, want := range generatorMultiples {
+		p := g.Generator().Mul(g.NewScalar(big.NewInt(int64(i))))
+		got := hex.EncodeToString(encode(p))
+		if got != want {
+			t.Errorf("%d*B: got %s, want %s", i, got, want)
+		}
+	}
+}
+
+func TestInvalidEncodings(t *testing.T) {
+	g := ristretto255.NewGenerator()
+	invalid := []string{
+		// Non-canonical field encodings.
+		"00ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		"edffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+		// Negative field elements.
+		"0100000000000000000000000000000000000000000000000000000000000000",
+		"01ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f",
+	}
+	for _, s := range invalid {
+		b, _ := hex.DecodeString(s)
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("decoding %s should fail", s)
+				}
+			}()
+			g.NewPoint(decodeInt(b), nil)
+		}()
+	}
+}
+
+// Element derivation vectors from Appendix A.3 of RFC 9496, as pairs of
+// 64-byte input and expected encoding.
+var uniformBytesVectors = [][2]string{
+	{
+		"5d1be09e3d0c82fc538112490e35701979d99e06ca3e2b5b54bffe8b4dc772c14d98b696a1bbfb5ca32c436cc61c16563790306c79eaca7705668b47dffe5bb6",
+		"3066f82a1a747d45120d1740f14358531a8f04bbffe6a819f86dfe50f44a0a46",
+	},
+	{
+		"f116b34b8f17ceb56e8732a60d913dd10cce47a6d53bee9204be8b44f6678b270102a56902e2488c46120e9276cfe54638286b9e4b3cdb470b542d46c2068d38",
+		"f26e5b6f7d362d2d2a94c5d0e7602cb4773c95a2e5c31a64f133189fa76ed61b",
+	},
+	{
+		"8422e1bbdaab52938b81fd602effb6f89110e1e57208ad12d9ad767e2e25510c27140775f9337088b982d83d7fcf0b2fa1edffe51952cbe7365e95c86eaf325c",
+		"006ccd2a9e6867e6a2c5cea83d3302cc9de128dd2a9a57dd8ee7b9d7ffe02826",
+	},
+	{
+		"ac22415129b61427bf464e17baee8db65940c233b98afce8d17c57beeb7876c2150d15af1cb1fb824bbd14955f2b57d08d388aab431a391cfc33d5bafb5dbbaf",
+		"f8f0c87cf237953c5890aec3998169005dae3eca1fbb04548c635953c817f92a",
+	},
+	{
+		"165d697a1ef3d5cf3c38565beefcf88c0f282b8e7dbd28544c483432f1cec7675debea8ebb4e5fe7d6f6e5db15f15587ac4d4d4a1de7191e0c1ca6664abcc413",
+		"ae81e7dedf20a497e10c304a765c1767a42d6e06029758d2d7e8ef7cc4c41179",
+	},
+	{
+		"a836e6c9a9ca9f1e8d486273ad56a78c70cf18f0ce10abb1c7172ddd605d7fd2979854f47ae1ccf204a33102095b4200e5befc0465accc263175485f0e17ea5c",
+		"e2705652ff9f5e44d3e841bf1c251cf7dddb77d140870d1ab2ed64f1a9ce8628",
+	},
+	{
+		"2cdc11eaeb95daf01189417cdddbf95952993aa9cb9c640eb5058d09702c74622c9965a697a3b345ec24ee56335b556e677b30e6f90ac77d781064f866a3c982",
+		"80bd07262511cdde4863f8a7434cef696750681cb9510eea557088f76d9e5065",
+	},
+}
+
+func TestUniformBytes(t *testing.T) {
+	g := ristretto255.NewGenerator()
+	for i, v := range uniformBytesVectors {
+		b, _ := hex.DecodeString(v[0])
+		p, err := g.NewPointFromUniformBytes(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := hex.EncodeToString(encode(p))
+		if got != v[1] {
+			t.Errorf("vector %d: got %s, want %s", i, got, v[1])
+		}
+	}
+
+	// A random element must round-trip through its canonical encoding.
+	b := make([]byte, 64)
+	_, err := rand.Read(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := g.NewPointFromUniformBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := g.NewPoint(p.X(), p.Y())
+	if !bytes.Equal(encode(p), encode(q)) {
+		t.Error("derived element should round-trip")
+	}
+}
+
+func TestEncodeToPoint(t *testing.T) {
+	g := ristretto255.NewGenerator()
+	msg := []byte("Hi, Singapore!")
+	p, err := g.EncodeToPoint(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(msg, g.DecodeFromPoint(p)) {
+		t.Error("decoded message should equal encoded message")
+	}
+}
+
+func encode(p curve.Point[ristretto255.Curve]) []byte {
+	b := p.X().FillBytes(make([]byte, 32))
+	reverse(b)
+	return b
+}
+
+func decodeInt(b []byte) *big.Int {
+	rev := append([]byte{}, b...)
+	reverse(rev)
+	return new(big.Int).SetBytes(rev)
+}
+
+func reverse(b []byte) {
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+}
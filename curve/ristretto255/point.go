@@ -0,0 +1,300 @@
+package ristretto255
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"filippo.io/edwards25519"
+	"filippo.io/edwards25519/field"
+	"github.com/matthiasgeihs/go-curve/curve"
+)
+
+// Point is a ristretto255 element, represented by one of the edwards25519
+// points in its equivalence class.
+type Point struct {
+	p *edwards25519.Point
+}
+
+// Check that type implements interface.
+var _ curve.Point[Curve] = Point{}
+var _ curve.MultiScalarMulPoint[Curve] = Point{}
+
+// Constants from Section 4.1 of RFC 9496.
+var (
+	sqrtM1 = newFieldElementFromDecimal(
+		"19681161376707505956807079304988542015446066515923890162744021073123829784752")
+	d = newFieldElementFromDecimal(
+		"37095705934669439343138083508754565189542113879843219016388785533085940283555")
+	sqrtADMinusOne = newFieldElementFromDecimal(
+		"25063068953384623474111414158702152701244531502492656460079210482610430750235")
+	invSqrtAMinusD = newFieldElementFromDecimal(
+		"54469307008909316920995813868745141605393597292927456921205312896311721017578")
+	oneMinusDSq = newFieldElementFromDecimal(
+		"1159843021668779879193775521855586647937357759715417654439879720876111806838")
+	dMinusOneSq = newFieldElementFromDecimal(
+		"40440834346308536858101042469323190826248399146238708352240133220865137265952")
+)
+
+func newFieldElementFromDecimal(s string) *field.Element {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid constant")
+	}
+	fe, err := new(field.Element).SetBytes(littleEndian(v, elementSize))
+	if err != nil {
+		panic(err)
+	}
+	return fe
+}
+
+func makePoint(p *edwards25519.Point) Point {
+	return Point{
+		p: p,
+	}
+}
+
+// decodePoint decodes a point from its canonical encoding as specified in
+// Section 4.3.1 of RFC 9496.
+func decodePoint(b []byte) (Point, error) {
+	if len(b) != elementSize {
+		return Point{}, fmt.Errorf("invalid length")
+	}
+
+	// Check that s is canonical and non-negative.
+	s, err := new(field.Element).SetBytes(b)
+	if err != nil {
+		return Point{}, fmt.Errorf("decoding field element: %w", err)
+	}
+	if !bytes.Equal(s.Bytes(), b) {
+		return Point{}, fmt.Errorf("non-canonical encoding")
+	}
+	if s.IsNegative() == 1 {
+		return Point{}, fmt.Errorf("negative encoding")
+	}
+
+	one := new(field.Element).One()
+	ss := new(field.Element).Square(s)
+	u1 := new(field.Element).Subtract(one, ss)
+	u2 := new(field.Element).Add(one, ss)
+	u2Sqr := new(field.Element).Square(u2)
+
+	// v = -(D * u1^2) - u2_sqr
+	v := new(field.Element).Square(u1)
+	v.Multiply(v, d)
+	v.Negate(v)
+	v.Subtract(v, u2Sqr)
+
+	invSqrt, wasSquare := new(field.Element).SqrtRatio(one, new(field.Element).Multiply(v, u2Sqr))
+
+	denX := new(field.Element).Multiply(invSqrt, u2)
+	denY := new(field.Element).Multiply(invSqrt, denX)
+	denY.Multiply(denY, v)
+
+	x := new(field.Element).Multiply(s, denX)
+	x.Add(x, x)
+	x.Absolute(x)
+	y := new(field.Element).Multiply(u1, denY)
+	t := new(field.Element).Multiply(x, y)
+
+	zero := new(field.Element).Zero()
+	if wasSquare == 0 || t.IsNegative() == 1 || y.Equal(zero) == 1 {
+		return Point{}, fmt.Errorf("invalid encoding")
+	}
+
+	p, err := new(edwards25519.Point).SetExtendedCoordinates(x, y, one, t)
+	if err != nil {
+		return Point{}, fmt.Errorf("creating point from extended coordinates: %w", err)
+	}
+	return makePoint(p), nil
+}
+
+// bytes returns the canonical encoding of p as specified in Section 4.3.2 of
+// RFC 9496.
+func (p Point) bytes() []byte {
+	x0, y0, z0, t0 := p.p.ExtendedCoordinates()
+
+	u1 := new(field.Element).Add(z0, y0)
+	u1.Multiply(u1, new(field.Element).Subtract(z0, y0))
+	u2 := new(field.Element).Multiply(x0, y0)
+
+	one := new(field.Element).One()
+	u2Sqr := new(field.Element).Square(u2)
+	invSqrt, _ := new(field.Element).SqrtRatio(one, new(field.Element).Multiply(u1, u2Sqr))
+	den1 := new(field.Element).Multiply(invSqrt, u1)
+	den2 := new(field.Element).Multiply(invSqrt, u2)
+	zInv := new(field.Element).Multiply(den1, den2)
+	zInv.Multiply(zInv, t0)
+
+	ix0 := new(field.Element).Multiply(x0, sqrtM1)
+	iy0 := new(field.Element).Multiply(y0, sqrtM1)
+	enchantedDenominator := new(field.Element).Multiply(den1, invSqrtAMinusD)
+
+	rotate := new(field.Element).Multiply(t0, zInv).IsNegative()
+	x := new(field.Element).Select(iy0, x0, rotate)
+	y := new(field.Element).Select(ix0, y0, rotate)
+	denInv := new(field.Element).Select(enchantedDenominator, den2, rotate)
+
+	yNeg := new(field.Element).Negate(y)
+	y.Select(yNeg, y, new(field.Element).Multiply(x, zInv).IsNegative())
+
+	s := new(field.Element).Subtract(z0, y)
+	s.Multiply(s, denInv)
+	s.Absolute(s)
+	return s.Bytes()
+}
+
+// makePointFromUniformBytes derives a point from 64 uniformly random bytes as
+// specified in Section 4.3.4 of RFC 9496.
+func makePointFromUniformBytes(b []byte) (Point, error) {
+	if len(b) != 2*elementSize {
+		return Point{}, fmt.Errorf("invalid length")
+	}
+
+	p1, err := mapToPoint(b[:elementSize])
+	if err != nil {
+		return Point{}, err
+	}
+	p2, err := mapToPoint(b[elementSize:])
+	if err != nil {
+		return Point{}, err
+	}
+	sum := new(edwards25519.Point).Add(p1, p2)
+	return makePoint(sum), nil
+}
+
+// mapToPoint implements the function MAP from Section 4.3.4 of RFC 9496.
+func mapToPoint(b []byte) (*edwards25519.Point, error) {
+	// The most significant bit is ignored by SetBytes.
+	t, err := new(field.Element).SetBytes(b)
+	if err != nil {
+		return nil, fmt.Errorf("decoding field element: %w", err)
+	}
+
+	one := new(field.Element).One()
+	minusOne := new(field.Element).Negate(one)
+
+	// r = SQRT_M1 * t^2
+	r := new(field.Element).Square(t)
+	r.Multiply(r, sqrtM1)
+
+	// u = (r + 1) * ONE_MINUS_D_SQ
+	u := new(field.Element).Add(r, one)
+	u.Multiply(u, oneMinusDSq)
+
+	// v = (-1 - r*D) * (r + D)
+	v := new(field.Element).Multiply(r, d)
+	v.Subtract(minusOne, v)
+	v.Multiply(v, new(field.Element).Add(r, d))
+
+	s, wasSquare := new(field.Element).SqrtRatio(u, v)
+	sPrime := new(field.Element).Multiply(s, t)
+	sPrime.Absolute(sPrime)
+	sPrime.Negate(sPrime)
+	s.Select(s, sPrime, wasSquare)
+	c := new(field.Element).Select(minusOne, r, wasSquare)
+
+	// N = c * (r - 1) * D_MINUS_ONE_SQ - v
+	n := new(field.Element).Subtract(r, one)
+	n.Multiply(n, c)
+	n.Multiply(n, dMinusOneSq)
+	n.Subtract(n, v)
+
+	ss := new(field.Element).Square(s)
+	w0 := new(field.Element).Multiply(s, v)
+	w0.Add(w0, w0)
+	w1 := new(field.Element).Multiply(n, sqrtADMinusOne)
+	w2 := new(field.Element).Subtract(one, ss)
+	w3 := new(field.Element).Add(one, ss)
+
+	p, err := new(edwards25519.Point).SetExtendedCoordinates(
+		new(field.Element).Multiply(w0, w3),
+		new(field.Element).Multiply(w2, w1),
+		new(field.Element).Multiply(w1, w3),
+		new(field.Element).Multiply(w0, w2),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("creating point from extended coordinates: %w", err)
+	}
+	return p, nil
+}
+
+// Bytes returns the canonical 32-byte encoding of p.
+func (p Point) Bytes() []byte {
+	return p.bytes()
+}
+
+// CompressedBytes returns the canonical 32-byte encoding of p, which is
+// already compressed.
+func (p Point) CompressedBytes() []byte {
+	return p.bytes()
+}
+
+// X returns the canonical encoding of p interpreted as a little-endian
+// integer.
+func (p Point) X() *big.Int {
+	b := p.bytes()
+	reverse(b)
+	return new(big.Int).SetBytes(b)
+}
+
+// Y returns zero, as ristretto255 elements have no affine coordinates.
+func (p Point) Y() *big.Int {
+	return new(big.Int)
+}
+
+func (p Point) Add(q curve.Point[Curve]) curve.Point[Curve] {
+	sum := new(edwards25519.Point).Add(p.p, q.(Point).p)
+	return makePoint(sum)
+}
+
+func (p Point) Mul(s curve.Scalar[Curve]) curve.Point[Curve] {
+	prod := new(edwards25519.Point).ScalarMult(s.(Scalar).v, p.p)
+	return makePoint(prod)
+}
+
+// Equal implements the equality check from Section 4.3.3 of RFC 9496.
+func (p Point) Equal(q curve.Point[Curve]) bool {
+	x1, y1, _, _ := p.p.ExtendedCoordinates()
+	x2, y2, _, _ := q.(Point).p.ExtendedCoordinates()
+	x1y2 := new(field.Element).Multiply(x1, y2)
+	y1x2 := new(field.Element).Multiply(y1, x2)
+	y1y2 := new(field.Element).Multiply(y1, y2)
+	x1x2 := new(field.Element).Multiply(x1, x2)
+	return x1y2.Equal(y1x2)|y1y2.Equal(x1x2) == 1
+}
+
+// MultiScalarMul returns the sum of scalars[i]*points[i] using the
+// variable-time multi-scalar multiplication of the edwards25519 package.
+func (Point) MultiScalarMul(
+	points []curve.Point[Curve],
+	scalars []curve.Scalar[Curve],
+) curve.Point[Curve] {
+	ps := make([]*edwards25519.Point, len(points))
+	ss := make([]*edwards25519.Scalar, len(scalars))
+	for i := range points {
+		ps[i] = points[i].(Point).p
+		ss[i] = scalars[i].(Scalar).v
+	}
+	prod := new(edwards25519.Point).VarTimeMultiScalarMult(ss, ps)
+	return makePoint(prod)
+}
+
+func (p Point) Neg() curve.Point[Curve] {
+	neg := new(edwards25519.Point).Negate(p.p)
+	return makePoint(neg)
+}
+
+func (p Point) Sub(q curve.Point[Curve]) curve.Point[Curve] {
+	diff := new(edwards25519.Point).Subtract(p.p, q.(Point).p)
+	return makePoint(diff)
+}
+
+func (p Point) Double() curve.Point[Curve] {
+	double := new(edwards25519.Point).Add(p.p, p.p)
+	return makePoint(double)
+}
+
+func (p Point) IsIdentity() bool {
+	return p.Equal(makePoint(edwards25519.NewIdentityPoint()))
+}
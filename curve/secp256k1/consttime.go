@@ -0,0 +1,289 @@
+package secp256k1
+
+import (
+	"crypto/subtle"
+	"encoding/binary"
+	"sync"
+
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+// This file implements constant-time scalar multiplication and inversion for
+// use with secret scalars. Point arithmetic uses the complete addition
+// formulas for short Weierstrass curves with a = 0 from Renes, Costello and
+// Batina, "Complete addition formulas for prime order elliptic curves",
+// EUROCRYPT 2016, which have no exceptional cases and hence no
+// secret-dependent branches.
+//
+// Field elements have magnitude 1 throughout: products have magnitude 1 by
+// construction, and all other results are normalized.
+
+// b3 is 3*b for the curve equation y^2 = x^3 + 7.
+const b3 = 21
+
+// windowSize is the bit width of the fixed window used for scalar
+// multiplication.
+const windowSize = 4
+const windowTableSize = 1 << windowSize
+
+// projectivePoint is a point in homogeneous projective coordinates (X:Y:Z)
+// representing the affine point (X/Z, Y/Z). The identity is (0:1:0).
+type projectivePoint struct {
+	x, y, z secp.FieldVal
+}
+
+func projectiveIdentity() projectivePoint {
+	var p projectivePoint
+	p.y.SetInt(1)
+	return p
+}
+
+// makeProjectivePoint converts a Jacobian point to projective coordinates.
+func makeProjectivePoint(p *secp.JacobianPoint) projectivePoint {
+	var a secp.JacobianPoint
+	a.Set(p)
+	a.ToAffine()
+	if a.X.IsZero() && a.Y.IsZero() {
+		return projectiveIdentity()
+	}
+
+	var r projectivePoint
+	r.x.Set(&a.X)
+	r.y.Set(&a.Y)
+	r.z.SetInt(1)
+	return r
+}
+
+// jacobian converts p to Jacobian coordinates (X*Z, Y*Z^2, Z), which maps the
+// identity to the Jacobian point at infinity.
+func (p *projectivePoint) jacobian() secp.JacobianPoint {
+	x := feMul(&p.x, &p.z)
+	zz := feMul(&p.z, &p.z)
+	y := feMul(&p.y, &zz)
+	z := p.z
+	x.Normalize()
+	y.Normalize()
+	z.Normalize()
+	return secp.MakeJacobianPoint(&x, &y, &z)
+}
+
+// add sets r = p + q using Algorithm 7 from Renes et al.
+func (r *projectivePoint) add(p, q *projectivePoint) {
+	t0 := feMul(&p.x, &q.x)
+	t1 := feMul(&p.y, &q.y)
+	t2 := feMul(&p.z, &q.z)
+	t3 := feAdd(&p.x, &p.y)
+	t4 := feAdd(&q.x, &q.y)
+	t3 = feMul(&t3, &t4)
+	t4 = feAdd(&t0, &t1)
+	t3 = feSub(&t3, &t4)
+	t4 = feAdd(&p.y, &p.z)
+	x3 := feAdd(&q.y, &q.z)
+	t4 = feMul(&t4, &x3)
+	x3 = feAdd(&t1, &t2)
+	t4 = feSub(&t4, &x3)
+	x3 = feAdd(&p.x, &p.z)
+	y3 := feAdd(&q.x, &q.z)
+	x3 = feMul(&x3, &y3)
+	y3 = feAdd(&t0, &t2)
+	y3 = feSub(&x3, &y3)
+	x3 = feAdd(&t0, &t0)
+	t0 = feAdd(&x3, &t0)
+	t2 = feMulInt(&t2, b3)
+	z3 := feAdd(&t1, &t2)
+	t1 = feSub(&t1, &t2)
+	y3 = feMulInt(&y3, b3)
+	x3 = feMul(&t4, &y3)
+	t2 = feMul(&t3, &t1)
+	x3 = feSub(&t2, &x3)
+	y3 = feMul(&y3, &t0)
+	t1 = feMul(&t1, &z3)
+	y3 = feAdd(&t1, &y3)
+	t0 = feMul(&t0, &t3)
+	z3 = feMul(&z3, &t4)
+	z3 = feAdd(&z3, &t0)
+
+	r.x, r.y, r.z = x3, y3, z3
+}
+
+// double sets r = 2p using Algorithm 9 from Renes et al.
+func (r *projectivePoint) double(p *projectivePoint) {
+	t0 := feMul(&p.y, &p.y)
+	z3 := feAdd(&t0, &t0)
+	z3 = feAdd(&z3, &z3)
+	z3 = feAdd(&z3, &z3)
+	t1 := feMul(&p.y, &p.z)
+	t2 := feMul(&p.z, &p.z)
+	t2 = feMulInt(&t2, b3)
+	x3 := feMul(&t2, &z3)
+	y3 := feAdd(&t0, &t2)
+	z3 = feMul(&t1, &z3)
+	t1 = feAdd(&t2, &t2)
+	t2 = feAdd(&t1, &t2)
+	t0 = feSub(&t0, &t2)
+	y3 = feMul(&t0, &y3)
+	y3 = feAdd(&x3, &y3)
+	t1 = feMul(&p.x, &p.y)
+	x3 = feMul(&t0, &t1)
+	x3 = feAdd(&x3, &x3)
+
+	r.x, r.y, r.z = x3, y3, z3
+}
+
+// encodedPoint holds the coordinates of a projective point as 64-bit words,
+// which allows for fast constant-time selection.
+type encodedPoint [12]uint64
+
+func (p *projectivePoint) encode() encodedPoint {
+	var e encodedPoint
+	var b [32]byte
+	for i, f := range [3]secp.FieldVal{p.x, p.y, p.z} {
+		f.Normalize().PutBytes(&b)
+		for j := 0; j < 4; j++ {
+			e[4*i+j] = binary.BigEndian.Uint64(b[8*j:])
+		}
+	}
+	return e
+}
+
+func (e *encodedPoint) decode() projectivePoint {
+	var p projectivePoint
+	var b [32]byte
+	for i, f := range [3]*secp.FieldVal{&p.x, &p.y, &p.z} {
+		for j := 0; j < 4; j++ {
+			binary.BigEndian.PutUint64(b[8*j:], e[4*i+j])
+		}
+		f.SetBytes(&b)
+	}
+	return p
+}
+
+// windowTable holds the multiples 0*P, ..., 15*P of a point P.
+type windowTable [windowTableSize]encodedPoint
+
+func makeWindowTable(p *projectivePoint) windowTable {
+	var t windowTable
+	q := projectiveIdentity()
+	for i := range t {
+		t[i] = q.encode()
+		q.add(&q, p)
+	}
+	return t
+}
+
+// lookup returns the entry at index i without secret-dependent branches or
+// memory access.
+func (t *windowTable) lookup(i byte) projectivePoint {
+	var e encodedPoint
+	for j := range t {
+		mask := -uint64(subtle.ConstantTimeByteEq(byte(j), i))
+		for k := range e {
+			e[k] |= t[j][k] & mask
+		}
+	}
+	return e.decode()
+}
+
+// scalarMultConst computes k*p in constant time with respect to k using a
+// fixed window of width 4.
+func scalarMultConst(k *secp.ModNScalar, p *secp.JacobianPoint) secp.JacobianPoint {
+	pp := makeProjectivePoint(p)
+	table := makeWindowTable(&pp)
+
+	kb := k.Bytes()
+	acc := projectiveIdentity()
+	for _, b := range kb {
+		for _, w := range [2]byte{b >> windowSize, b & (windowTableSize - 1)} {
+			for i := 0; i < windowSize; i++ {
+				acc.double(&acc)
+			}
+			q := table.lookup(w)
+			acc.add(&acc, &q)
+		}
+	}
+	return acc.jacobian()
+}
+
+// baseTableWindows is the number of 4-bit windows of a 256-bit scalar.
+const baseTableWindows = 256 / windowSize
+
+// baseTable holds, for each window i, the multiples j * 16^i * G for
+// j = 0, ..., 15 of the generator G. It is computed on first use.
+var baseTable struct {
+	once   sync.Once
+	tables [baseTableWindows]windowTable
+}
+
+func computeBaseTable() {
+	var g secp.JacobianPoint
+	secp.ScalarBaseMultNonConst(new(secp.ModNScalar).SetInt(1), &g)
+	p := makeProjectivePoint(&g)
+	for i := range baseTable.tables {
+		baseTable.tables[i] = makeWindowTable(&p)
+		for j := 0; j < windowSize; j++ {
+			p.double(&p)
+		}
+	}
+}
+
+// scalarBaseMultConst computes k*G in constant time with respect to k. Using
+// the precomputed table, it requires no doublings and one addition per
+// window.
+func scalarBaseMultConst(k *secp.ModNScalar) secp.JacobianPoint {
+	baseTable.once.Do(computeBaseTable)
+
+	kb := k.Bytes()
+	acc := projectiveIdentity()
+	for i := range baseTable.tables {
+		// Window i covers bits 4i to 4i+3 of the big-endian scalar.
+		b := kb[len(kb)-1-i/2]
+		w := (b >> (windowSize * (i % 2))) & (windowTableSize - 1)
+		q := baseTable.tables[i].lookup(w)
+		acc.add(&acc, &q)
+	}
+	return acc.jacobian()
+}
+
+// inverseConst computes the multiplicative inverse of s modulo the group order
+// in constant time as s^(N-2). Zero is mapped to zero.
+func inverseConst(s *secp.ModNScalar) *secp.ModNScalar {
+	exp := new(secp.ModNScalar).SetInt(2)
+	exp.Negate()
+	e := exp.Bytes()
+
+	r := new(secp.ModNScalar).SetInt(1)
+	for _, b := range e {
+		for i := 7; i >= 0; i-- {
+			r.Square()
+			// The exponent is public, hence branching on its bits is safe.
+			if (b>>i)&1 == 1 {
+				r.Mul(s)
+			}
+		}
+	}
+	return r
+}
+
+func feAdd(a, b *secp.FieldVal) secp.FieldVal {
+	var r secp.FieldVal
+	r.Add2(a, b).Normalize()
+	return r
+}
+
+func feSub(a, b *secp.FieldVal) secp.FieldVal {
+	var r secp.FieldVal
+	r.NegateVal(b, 1).Add(a).Normalize()
+	return r
+}
+
+func feMul(a, b *secp.FieldVal) secp.FieldVal {
+	var r secp.FieldVal
+	r.Mul2(a, b)
+	return r
+}
+
+func feMulInt(a *secp.FieldVal, v uint8) secp.FieldVal {
+	var r secp.FieldVal
+	r.Set(a).MulInt(v).Normalize()
+	return r
+}
@@ -7,6 +7,7 @@ import (
 
 	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/internal/h2c"
 )
 
 type Curve struct {
@@ -35,35 +36,85 @@ func (Curve) NewPoint(x, y *big.Int) curve.Point[Curve] {
 	return makePointBigInt(x, y)
 }
 
+func (Curve) DecodePoint(b []byte) (curve.Point[Curve], error) {
+	p, err := decodePoint(b)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
 func (Curve) Generator() curve.Point[Curve] {
 	params := secp.Params()
 	return makePointBigInt(params.Gx, params.Gy)
 }
 
+// MulBase returns s times the generator in constant time with respect to s,
+// using a precomputed table of multiples of the generator.
+func (Curve) MulBase(s curve.Scalar[Curve]) curve.Point[Curve] {
+	prod := scalarBaseMultConst(s.(Scalar).v)
+	return makePoint(&prod)
+}
+
+func (Curve) Identity() curve.Point[Curve] {
+	var inf secp.JacobianPoint
+	return makePoint(&inf)
+}
+
+func (Curve) Name() string {
+	return "secp256k1"
+}
+
 func (Curve) GeneratorOrder() *big.Int {
 	return new(big.Int).Set(secp.Params().N)
 }
 
+// RandomScalar samples a uniformly random non-zero scalar by rejection
+// sampling.
 func (Curve) RandomScalar(rand io.Reader) (curve.Scalar[Curve], error) {
-	buf := make([]byte, secp.Params().ByteSize)
-	_, err := rand.Read(buf)
-	if err != nil {
-		return nil, err
-	}
+	var buf [scalarByteSize]byte
+	for {
+		_, err := io.ReadFull(rand, buf[:])
+		if err != nil {
+			return nil, err
+		}
 
-	rbi := new(big.Int).SetBytes(buf)
-	return makeScalarFromBigInt(rbi), nil
+		var v secp.ModNScalar
+		overflow := v.SetBytes(&buf)
+		if overflow == 0 && !v.IsZero() {
+			return makeScalar(&v), nil
+		}
+	}
 }
 
 func (Curve) NewScalar(v *big.Int) curve.Scalar[Curve] {
 	return makeScalarFromBigInt(v)
 }
 
-func (Curve) HashToScalar(data []byte) curve.Scalar[Curve] {
-	h := sha256.Sum256(data)
-	var v secp.ModNScalar
-	v.SetByteSlice(h[:])
-	return makeScalar(&v)
+func (Curve) Zero() curve.Scalar[Curve] {
+	return makeScalar(new(secp.ModNScalar))
+}
+
+func (Curve) One() curve.Scalar[Curve] {
+	return makeScalar(new(secp.ModNScalar).SetInt(1))
+}
+
+func (Curve) DecodeScalar(b []byte) (curve.Scalar[Curve], error) {
+	s, err := decodeScalar(b)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// HashToScalar hashes msg to a scalar using hash_to_field from RFC 9380 with
+// expand_message_xmd, SHA-256 and domain separation tag dst.
+func (Curve) HashToScalar(dst, msg []byte) curve.Scalar[Curve] {
+	u, err := h2c.HashToField(sha256.New, msg, dst, secp.Params().N, 1, hashToFieldLength)
+	if err != nil {
+		panic(err)
+	}
+	return makeScalarFromBigInt(u[0])
 }
 
 func (c Curve) EncodeToPoint(data []byte) (curve.Point[Curve], error) {
@@ -73,3 +124,9 @@ func (c Curve) EncodeToPoint(data []byte) (curve.Point[Curve], error) {
 func (c Curve) DecodeFromPoint(p curve.Point[Curve]) []byte {
 	return c.encoder.DecodeFromPoint(p)
 }
+
+// HashToPoint hashes msg to a point using the suite
+// secp256k1_XMD:SHA-256_SSWU_RO_ from RFC 9380 with domain separation tag dst.
+func (Curve) HashToPoint(dst, msg []byte) curve.Point[Curve] {
+	return hashToPoint(dst, msg)
+}
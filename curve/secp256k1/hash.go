@@ -0,0 +1,106 @@
+package secp256k1
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/matthiasgeihs/go-curve/curve/internal/h2c"
+)
+
+// Parameters of the suite secp256k1_XMD:SHA-256_SSWU_RO_ from Section 8.7 of
+// RFC 9380. The simplified SWU map is applied to the 3-isogenous curve E' and
+// the result is mapped to secp256k1 with the isogeny from Appendix E.1.
+const hashToFieldLength = 48
+
+var isoCurve = h2c.Weierstrass{
+	P: secp.Params().P,
+	A: hexToBigInt("3f8731abdd661adca08a5558f0f5d272e953d363cb6f0e5d405447c01a444533"),
+	B: big.NewInt(1771),
+	Z: new(big.Int).Sub(secp.Params().P, big.NewInt(11)),
+}
+
+var (
+	isoXNum = []*big.Int{
+		hexToBigInt("8e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38daaaaa8c7"),
+		hexToBigInt("07d3d4c80bc321d5b9f315cea7fd44c5d595d2fc0bf63b92dfff1044f17c6581"),
+		hexToBigInt("534c328d23f234e6e2a413deca25caece4506144037c40314ecbd0b53d9dd262"),
+		hexToBigInt("8e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38e38daaaaa88c"),
+	}
+	isoXDen = []*big.Int{
+		hexToBigInt("d35771193d94918a9ca34ccbb7b640dd86cd409542f8487d9fe6b745781eb49b"),
+		hexToBigInt("edadc6f64383dc1df7c4b2d51b54225406d36b641f5e41bbc52a56612a8c6d14"),
+		big.NewInt(1),
+	}
+	isoYNum = []*big.Int{
+		hexToBigInt("4bda12f684bda12f684bda12f684bda12f684bda12f684bda12f684b8e38e23c"),
+		hexToBigInt("c75e0c32d5cb7c0fa9d0a54b12a0a6d5647ab046d686da6fdffc90fc201d71a3"),
+		hexToBigInt("29a6194691f91a73715209ef6512e576722830a201be2018a765e85a9ecee931"),
+		hexToBigInt("2f684bda12f684bda12f684bda12f684bda12f684bda12f684bda12f38e38d84"),
+	}
+	isoYDen = []*big.Int{
+		hexToBigInt("fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffff93b"),
+		hexToBigInt("7a06534bb8bdb49fd5e9e6632722c2989467c1bfc8e8d978dfb425d2685c2573"),
+		hexToBigInt("6484aa716545ca2cf3a70c3fa8fe337e0a3d21162f0d6299a7bf8192bfd2a76f"),
+		big.NewInt(1),
+	}
+)
+
+func hexToBigInt(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("invalid constant")
+	}
+	return v
+}
+
+// mapToCurve maps a field element to a point using the simplified SWU map for
+// E' followed by the 3-isogeny to secp256k1.
+func mapToCurve(u *big.Int) Point {
+	xPrime, yPrime := isoCurve.MapToCurveSSWU(u)
+	return isoMap(xPrime, yPrime)
+}
+
+// isoMap evaluates the 3-isogeny map from E' to secp256k1. The denominators
+// vanish exactly on the kernel of the isogeny, which is mapped to the
+// identity.
+func isoMap(xPrime, yPrime *big.Int) Point {
+	p := secp.Params().P
+	xNum := evalPoly(p, isoXNum, xPrime)
+	xDen := evalPoly(p, isoXDen, xPrime)
+	yNum := evalPoly(p, isoYNum, xPrime)
+	yDen := evalPoly(p, isoYDen, xPrime)
+	if xDen.Sign() == 0 || yDen.Sign() == 0 {
+		var inf secp.JacobianPoint
+		return makePoint(&inf)
+	}
+
+	x := new(big.Int).Mul(xNum, new(big.Int).ModInverse(xDen, p))
+	x.Mod(x, p)
+	y := new(big.Int).Mul(yNum, new(big.Int).ModInverse(yDen, p))
+	y.Mul(y, yPrime)
+	y.Mod(y, p)
+	return makePointBigInt(x, y)
+}
+
+// evalPoly evaluates the polynomial with coefficients k (lowest degree first)
+// at x.
+func evalPoly(p *big.Int, k []*big.Int, x *big.Int) *big.Int {
+	r := new(big.Int)
+	for i := len(k) - 1; i >= 0; i-- {
+		r.Mul(r, x)
+		r.Add(r, k[i])
+		r.Mod(r, p)
+	}
+	return r
+}
+
+func hashToPoint(dst, msg []byte) Point {
+	u, err := h2c.HashToField(sha256.New, msg, dst, secp.Params().P, 2, hashToFieldLength)
+	if err != nil {
+		panic(err)
+	}
+	q0 := mapToCurve(u[0])
+	q1 := mapToCurve(u[1])
+	return q0.Add(q1).(Point)
+}
@@ -0,0 +1,18 @@
+package secp256k1
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestIsoMap_kernel(t *testing.T) {
+	// The x-coordinate of the points of order 3 on E' that generate the
+	// kernel of the isogeny. It is the double root of the x denominator.
+	x := hexToBigInt("89291c84de3e11f1041da6957255eed5fc964a4df050df221d6ad4ce6ab9c5a5")
+	if evalPoly(isoCurve.P, isoXDen, x).Sign() != 0 {
+		t.Fatal("x should be a root of the x denominator")
+	}
+	if !isoMap(x, big.NewInt(1)).IsIdentity() {
+		t.Error("kernel should map to the identity")
+	}
+}
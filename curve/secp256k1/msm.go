@@ -0,0 +1,102 @@
+package secp256k1
+
+import (
+	"math/bits"
+
+	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/matthiasgeihs/go-curve/curve"
+)
+
+// strausThreshold is the number of terms up to which Straus' method is used.
+// Pippenger's bucket method is used for larger inputs.
+const strausThreshold = 64
+
+// MultiScalarMul returns the sum of scalars[i]*points[i] in variable time,
+// operating directly on Jacobian coordinates.
+func (Point) MultiScalarMul(
+	points []curve.Point[Curve],
+	scalars []curve.Scalar[Curve],
+) curve.Point[Curve] {
+	ps := make([]*secp.JacobianPoint, len(points))
+	ks := make([][scalarByteSize]byte, len(scalars))
+	for i := range points {
+		ps[i] = points[i].(Point).p
+		ks[i] = scalars[i].(Scalar).v.Bytes()
+	}
+
+	var r secp.JacobianPoint
+	if len(ps) <= strausThreshold {
+		r = straus(ps, ks)
+	} else {
+		r = pippenger(ps, ks)
+	}
+	return makePoint(&r)
+}
+
+func straus(ps []*secp.JacobianPoint, ks [][scalarByteSize]byte) secp.JacobianPoint {
+	tables := make([][windowTableSize]secp.JacobianPoint, len(ps))
+	for i, p := range ps {
+		for j := 1; j < windowTableSize; j++ {
+			secp.AddNonConst(&tables[i][j-1], p, &tables[i][j])
+		}
+	}
+
+	var acc secp.JacobianPoint
+	for w := 8*scalarByteSize/windowSize - 1; w >= 0; w-- {
+		for j := 0; j < windowSize; j++ {
+			secp.DoubleNonConst(&acc, &acc)
+		}
+		for i := range ks {
+			if d := digit(&ks[i], w*windowSize, windowSize); d != 0 {
+				secp.AddNonConst(&acc, &tables[i][d], &acc)
+			}
+		}
+	}
+	return acc
+}
+
+func pippenger(ps []*secp.JacobianPoint, ks [][scalarByteSize]byte) secp.JacobianPoint {
+	// A window size of about log2(n) balances the bucket accumulation against
+	// the bucket aggregation.
+	c := bits.Len(uint(len(ps))) - 2
+	buckets := make([]secp.JacobianPoint, 1<<c-1)
+
+	var acc secp.JacobianPoint
+	for w := (8*scalarByteSize+c-1)/c - 1; w >= 0; w-- {
+		for j := 0; j < c; j++ {
+			secp.DoubleNonConst(&acc, &acc)
+		}
+
+		for j := range buckets {
+			buckets[j] = secp.JacobianPoint{}
+		}
+		for i := range ks {
+			if d := digit(&ks[i], w*c, c); d != 0 {
+				secp.AddNonConst(&buckets[d-1], ps[i], &buckets[d-1])
+			}
+		}
+
+		// Compute sum_j j*buckets[j-1] using running sums.
+		var sum, running secp.JacobianPoint
+		for j := len(buckets) - 1; j >= 0; j-- {
+			secp.AddNonConst(&running, &buckets[j], &running)
+			secp.AddNonConst(&sum, &running, &sum)
+		}
+		secp.AddNonConst(&acc, &sum, &acc)
+	}
+	return acc
+}
+
+// digit returns the n bits of the big-endian integer k starting at bit i. Bits
+// beyond the length of k are zero.
+func digit(k *[scalarByteSize]byte, i, n int) uint {
+	var d uint
+	for j := n - 1; j >= 0; j-- {
+		b := i + j
+		if b >= 8*scalarByteSize {
+			continue
+		}
+		d = d<<1 | uint(k[scalarByteSize-1-b/8]>>(b%8)&1)
+	}
+	return d
+}
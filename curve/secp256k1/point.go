@@ -8,12 +8,22 @@ import (
 	"github.com/matthiasgeihs/go-curve/curve"
 )
 
+// Format bytes of SEC1 point encodings.
+const (
+	sec1Infinity     = 0x00
+	sec1Compressed0  = 0x02
+	sec1Compressed1  = 0x03
+	sec1Uncompressed = 0x04
+)
+
 type Point struct {
 	p *secp.JacobianPoint
 }
 
 // Check that type implements interface.
 var _ curve.Point[Curve] = Point{}
+var _ curve.ConstTimePoint[Curve] = Point{}
+var _ curve.MultiScalarMulPoint[Curve] = Point{}
 
 func makePoint(p *secp.JacobianPoint) Point {
 	return Point{
@@ -62,7 +72,7 @@ func (p Point) X() *big.Int {
 
 func (p Point) Y() *big.Int {
 	p.p.ToAffine()
-	b := p.p.X.Bytes()
+	b := p.p.Y.Bytes()
 	return new(big.Int).SetBytes(b[:])
 }
 
@@ -77,3 +87,88 @@ func (p Point) Mul(s curve.Scalar[Curve]) curve.Point[Curve] {
 	secp.ScalarMultNonConst(s.(Scalar).v, p.p, &prod)
 	return makePoint(&prod)
 }
+
+// MulConstTime returns s*p, computed in constant time with respect to s.
+func (p Point) MulConstTime(s curve.Scalar[Curve]) curve.Point[Curve] {
+	prod := scalarMultConst(s.(Scalar).v, p.p)
+	return makePoint(&prod)
+}
+
+func (p Point) Equal(q curve.Point[Curve]) bool {
+	qp := q.(Point).p
+	p.p.ToAffine()
+	qp.ToAffine()
+	return p.p.X.Equals(&qp.X) && p.p.Y.Equals(&qp.Y)
+}
+
+func (p Point) Neg() curve.Point[Curve] {
+	p.p.ToAffine()
+	var neg secp.JacobianPoint
+	neg.Set(p.p)
+	neg.Y.Negate(1).Normalize()
+	return makePoint(&neg)
+}
+
+func (p Point) Sub(q curve.Point[Curve]) curve.Point[Curve] {
+	return p.Add(q.Neg())
+}
+
+func (p Point) Double() curve.Point[Curve] {
+	var double secp.JacobianPoint
+	secp.DoubleNonConst(p.p, &double)
+	return makePoint(&double)
+}
+
+func (p Point) IsIdentity() bool {
+	p.p.ToAffine()
+	return p.isInfinity()
+}
+
+// isInfinity reports whether p is the point at infinity. It requires p to be
+// in affine coordinates.
+func (p Point) isInfinity() bool {
+	return p.p.X.IsZero() && p.p.Y.IsZero()
+}
+
+// Bytes returns the uncompressed SEC1 encoding of p. The point at infinity is
+// encoded as a single zero byte.
+func (p Point) Bytes() []byte {
+	p.p.ToAffine()
+	if p.isInfinity() {
+		return []byte{sec1Infinity}
+	}
+	return secp.NewPublicKey(&p.p.X, &p.p.Y).SerializeUncompressed()
+}
+
+// CompressedBytes returns the compressed SEC1 encoding of p. The point at
+// infinity is encoded as a single zero byte.
+func (p Point) CompressedBytes() []byte {
+	p.p.ToAffine()
+	if p.isInfinity() {
+		return []byte{sec1Infinity}
+	}
+	return secp.NewPublicKey(&p.p.X, &p.p.Y).SerializeCompressed()
+}
+
+// decodePoint decodes a point from its compressed or uncompressed SEC1
+// encoding. It rejects the point at infinity.
+func decodePoint(b []byte) (Point, error) {
+	if len(b) == 0 {
+		return Point{}, fmt.Errorf("empty encoding")
+	}
+	switch b[0] {
+	case sec1Compressed0, sec1Compressed1, sec1Uncompressed:
+	case sec1Infinity:
+		return Point{}, fmt.Errorf("point at infinity")
+	default:
+		return Point{}, fmt.Errorf("invalid format: %#x", b[0])
+	}
+
+	pk, err := secp.ParsePubKey(b)
+	if err != nil {
+		return Point{}, fmt.Errorf("parsing point: %w", err)
+	}
+	var jp secp.JacobianPoint
+	pk.AsJacobian(&jp)
+	return makePoint(&jp), nil
+}
@@ -1,18 +1,22 @@
 package secp256k1
 
 import (
+	"fmt"
 	"math/big"
 
 	secp "github.com/decred/dcrd/dcrec/secp256k1/v4"
 	"github.com/matthiasgeihs/go-curve/curve"
 )
 
+const scalarByteSize = 32
+
 type Scalar struct {
 	v *secp.ModNScalar
 }
 
 // Check that type implements interface.
 var _ curve.Scalar[Curve] = Scalar{}
+var _ curve.ConstTimeScalar[Curve] = Scalar{}
 
 func makeScalar(v *secp.ModNScalar) Scalar {
 	return Scalar{
@@ -38,6 +42,12 @@ func (s Scalar) Inv() curve.Scalar[Curve] {
 	return makeScalar(inv)
 }
 
+// InvConstTime returns the inverse of s, computed in constant time. The
+// inverse of zero is zero.
+func (s Scalar) InvConstTime() curve.Scalar[Curve] {
+	return makeScalar(inverseConst(s.v))
+}
+
 func (s Scalar) Add(t curve.Scalar[Curve]) curve.Scalar[Curve] {
 	var sum secp.ModNScalar
 	sum.Add2(s.v, t.(Scalar).v)
@@ -46,7 +56,7 @@ func (s Scalar) Add(t curve.Scalar[Curve]) curve.Scalar[Curve] {
 
 func (s Scalar) Sub(t curve.Scalar[Curve]) curve.Scalar[Curve] {
 	var sum secp.ModNScalar
-	sum.Add2(s.v, t.(Scalar).v.Negate())
+	sum.NegateVal(t.(Scalar).v).Add(s.v)
 	return makeScalar(&sum)
 }
 
@@ -64,3 +74,32 @@ func (s Scalar) Int() *big.Int {
 func (s Scalar) Equal(t curve.Scalar[Curve]) bool {
 	return s.v.Equals(t.(Scalar).v)
 }
+
+func (s Scalar) Neg() curve.Scalar[Curve] {
+	neg := new(secp.ModNScalar).NegateVal(s.v)
+	return makeScalar(neg)
+}
+
+func (s Scalar) IsZero() bool {
+	return s.v.IsZero()
+}
+
+// Bytes returns the 32-byte big-endian encoding of s.
+func (s Scalar) Bytes() []byte {
+	b := s.v.Bytes()
+	return b[:]
+}
+
+// decodeScalar decodes a scalar from its 32-byte big-endian encoding. It
+// rejects encodings of values greater than or equal to the group order.
+func decodeScalar(b []byte) (Scalar, error) {
+	if len(b) != scalarByteSize {
+		return Scalar{}, fmt.Errorf("invalid length: %d", len(b))
+	}
+	var v secp.ModNScalar
+	overflow := v.SetByteSlice(b)
+	if overflow {
+		return Scalar{}, fmt.Errorf("non-canonical encoding")
+	}
+	return makeScalar(&v), nil
+}
@@ -0,0 +1,31 @@
+package curve
+
+// ConstTimePoint is implemented by points that support scalar multiplication
+// in constant time with respect to the scalar.
+type ConstTimePoint[C Curve] interface {
+	MulConstTime(Scalar[C]) Point[C]
+}
+
+// ConstTimeScalar is implemented by scalars that support inversion in
+// constant time.
+type ConstTimeScalar[C Curve] interface {
+	InvConstTime() Scalar[C]
+}
+
+// MulSecret returns s*p for a secret scalar s. It uses constant-time scalar
+// multiplication if p supports it and falls back to p.Mul otherwise.
+func MulSecret[C Curve](p Point[C], s Scalar[C]) Point[C] {
+	if ct, ok := p.(ConstTimePoint[C]); ok {
+		return ct.MulConstTime(s)
+	}
+	return p.Mul(s)
+}
+
+// InvSecret returns the inverse of a secret scalar s. It uses constant-time
+// inversion if s supports it and falls back to s.Inv otherwise.
+func InvSecret[C Curve](s Scalar[C]) Scalar[C] {
+	if ct, ok := s.(ConstTimeScalar[C]); ok {
+		return ct.InvConstTime()
+	}
+	return s.Inv()
+}
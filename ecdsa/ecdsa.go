@@ -1,6 +1,7 @@
 package ecdsa
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"math/big"
@@ -14,6 +15,21 @@ type Sig[C curve.Curve] struct {
 	r, s curve.Scalar[C]
 }
 
+func MakeSig[C curve.Curve](r, s curve.Scalar[C]) Sig[C] {
+	return Sig[C]{
+		r: r,
+		s: s,
+	}
+}
+
+func (sig Sig[C]) R() curve.Scalar[C] {
+	return sig.r
+}
+
+func (sig Sig[C]) S() curve.Scalar[C] {
+	return sig.s
+}
+
 type ECDSA[C curve.Curve] struct {
 	gen curve.Generator[C]
 	rnd io.Reader
@@ -31,46 +47,65 @@ func (dsa *ECDSA[C]) KeyGen() (SecretKey[C], PubKey[C], error) {
 	if err != nil {
 		return nil, nil, fmt.Errorf("generating secret key: %w", err)
 	}
-	pk := dsa.gen.Generator().Mul(sk)
+	pk := dsa.gen.MulBase(sk)
 	return sk, pk, nil
 }
 
 func (dsa *ECDSA[C]) Sign(sk SecretKey[C], m []byte) (Sig[C], error) {
-	var k, r curve.Scalar[C]
+	z := dsa.hashMessage(m)
 	for {
-		var err error
-		k, err = dsa.gen.RandomScalar(dsa.rnd)
+		k, err := dsa.gen.RandomScalar(dsa.rnd)
 		if err != nil {
 			return Sig[C]{}, fmt.Errorf("generating nonce: %w", err)
 		}
-		gk := dsa.gen.Generator().Mul(k)
-		r = dsa.gen.NewScalar(gk.X())
+		gk := dsa.gen.MulBase(k)
+		r := dsa.gen.NewScalar(gk.X())
+		if r.IsZero() {
+			continue
+		}
 
-		zero := dsa.gen.NewScalar(big.NewInt(0))
-		if !r.Equal(zero) {
-			break
+		rsk := r.Mul(sk)
+		zrsk := z.Add(rsk)
+		s := curve.InvSecret(k).Mul(zrsk)
+		if s.IsZero() {
+			continue
 		}
+		return Sig[C]{
+			r: r,
+			s: s,
+		}, nil
 	}
-
-	z := dsa.gen.HashToScalar(m)
-	rsk := r.Mul(sk)
-	zrsk := z.Add(rsk)
-	s := k.Inv().Mul(zrsk)
-	return Sig[C]{
-		r: r,
-		s: s,
-	}, nil
 }
 
 func (dsa *ECDSA[C]) Verify(pk PubKey[C], m []byte, sig Sig[C]) bool {
-	z := dsa.gen.HashToScalar(m)
+	if sig.r.IsZero() || sig.s.IsZero() {
+		return false
+	}
+
+	z := dsa.hashMessage(m)
 	sinv := sig.s.Inv()
 	u1 := z.Mul(sinv)
 	u2 := sig.r.Mul(sinv)
 
-	gu1 := dsa.gen.Generator().Mul(u1)
-	pku2 := pk.Mul(u2)
-	gu1pku2 := gu1.Add(pku2)
+	gu1pku2 := curve.MultiScalarMul(
+		[]curve.Point[C]{dsa.gen.Generator(), pk},
+		[]curve.Scalar[C]{u1, u2},
+	)
+	if gu1pku2.IsIdentity() {
+		return false
+	}
 	gu1pku2x := dsa.gen.NewScalar(gu1pku2.X())
 	return sig.r.Equal(gu1pku2x)
 }
+
+// hashMessage computes the integer representation of the SHA-256 digest of m,
+// truncated to the bit length of the group order as specified in SEC 1.
+func (dsa *ECDSA[C]) hashMessage(m []byte) curve.Scalar[C] {
+	h := sha256.Sum256(m)
+	z := new(big.Int).SetBytes(h[:])
+	excessBits := len(h)*8 - dsa.gen.GeneratorOrder().BitLen()
+	if excessBits > 0 {
+		z.Rsh(z, uint(excessBits))
+	}
+	return dsa.gen.NewScalar(z)
+}
@@ -1,11 +1,18 @@
 package ecdsa_test
 
 import (
+	stdecdsa "crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/sha256"
 	"testing"
 
 	"github.com/matthiasgeihs/go-curve/curve"
 	"github.com/matthiasgeihs/go-curve/curve/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve/p256"
+	"github.com/matthiasgeihs/go-curve/curve/p384"
+	"github.com/matthiasgeihs/go-curve/curve/p521"
+	"github.com/matthiasgeihs/go-curve/curve/ristretto255"
 	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
 	"github.com/matthiasgeihs/go-curve/ecdsa"
 )
@@ -26,6 +33,35 @@ func TestECDSA_edwards25519(t *testing.T) {
 	testECDSA(t, instance)
 }
 
+func TestECDSA_ristretto255(t *testing.T) {
+	instance := ecdsa.NewECDSA[ristretto255.Curve](
+		ristretto255.NewGenerator(),
+		rand.Reader,
+	)
+	testECDSA(t, instance)
+}
+
+func TestECDSA_p256(t *testing.T) {
+	g := p256.NewGenerator()
+	instance := ecdsa.NewECDSA[p256.Curve](g, rand.Reader)
+	testECDSA(t, instance)
+	testStdlib[p256.Curve](t, instance, g, elliptic.P256())
+}
+
+func TestECDSA_p384(t *testing.T) {
+	g := p384.NewGenerator()
+	instance := ecdsa.NewECDSA[p384.Curve](g, rand.Reader)
+	testECDSA(t, instance)
+	testStdlib[p384.Curve](t, instance, g, elliptic.P384())
+}
+
+func TestECDSA_p521(t *testing.T) {
+	g := p521.NewGenerator()
+	instance := ecdsa.NewECDSA[p521.Curve](g, rand.Reader)
+	testECDSA(t, instance)
+	testStdlib[p521.Curve](t, instance, g, elliptic.P521())
+}
+
 func testECDSA[C curve.Curve](t *testing.T, instance ecdsa.ECDSA[C]) {
 	sk, pk, err := instance.KeyGen()
 	if err != nil {
@@ -49,3 +85,47 @@ func testECDSA[C curve.Curve](t *testing.T, instance ecdsa.ECDSA[C]) {
 		t.Error("Signature valid for different message")
 	}
 }
+
+// testStdlib checks that signatures are compatible with crypto/ecdsa.
+func testStdlib[C curve.Curve](
+	t *testing.T,
+	instance ecdsa.ECDSA[C],
+	g curve.Generator[C],
+	c elliptic.Curve,
+) {
+	msg := []byte("Hello, Singapore!")
+	h := sha256.Sum256(msg)
+
+	t.Run("verify stdlib", func(t *testing.T) {
+		sk, err := stdecdsa.GenerateKey(c, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r, s, err := stdecdsa.Sign(rand.Reader, sk, h[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pk := g.NewPoint(sk.X, sk.Y)
+		sig := ecdsa.MakeSig(g.NewScalar(r), g.NewScalar(s))
+		if !instance.Verify(pk, msg, sig) {
+			t.Error("crypto/ecdsa signature should be valid")
+		}
+	})
+
+	t.Run("sign stdlib", func(t *testing.T) {
+		sk, pk, err := instance.KeyGen()
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig, err := instance.Sign(sk, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		stdPk := &stdecdsa.PublicKey{Curve: c, X: pk.X(), Y: pk.Y()}
+		if !stdecdsa.Verify(stdPk, h[:], sig.R().Int(), sig.S().Int()) {
+			t.Error("signature should be valid for crypto/ecdsa")
+		}
+	})
+}
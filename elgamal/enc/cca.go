@@ -0,0 +1,119 @@
+package enc
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/sigma/dleq"
+	"github.com/matthiasgeihs/go-curve/sigma/nizk"
+)
+
+// tdh2DST is the domain separation tag of the second generator and the
+// validity proofs of CCA ciphertexts.
+var tdh2DST = []byte("go-curve-elgamal-tdh2-v1")
+
+// CCACiphertext is a chosen-ciphertext secure ElGamal ciphertext following
+// the TDH2 scheme of Shoup and Gennaro, "Securing Threshold Cryptosystems
+// against Chosen Ciphertext Attack", EUROCRYPT 1998. Besides (c1, c2) =
+// (y*G, m + y*pk), it contains u = y*H for a second generator H and a
+// Fiat-Shamir proof that log_G c1 = log_H u, which proves knowledge of y and
+// is bound to c2 and the label. Ciphertexts with an invalid proof are
+// rejected before any decryption, also in the threshold setting.
+type CCACiphertext[C curve.Curve] struct {
+	ct    Ciphertext[C]
+	u     curve.Point[C]
+	proof nizk.Proof
+}
+
+// EncryptCCA encrypts data to pk under the given label. The label is not
+// encrypted, but decryption under a different label fails.
+func (enc *Cipher[C]) EncryptCCA(pk PubKey[C], data, label []byte) (CCACiphertext[C], error) {
+	m, err := enc.gen.EncodeToPoint(data)
+	if err != nil {
+		return CCACiphertext[C]{}, fmt.Errorf("encoding data to point: %w", err)
+	}
+
+	y, err := enc.gen.RandomScalar(enc.rnd)
+	if err != nil {
+		return CCACiphertext[C]{}, fmt.Errorf("generating nonce: %w", err)
+	}
+	ct := Ciphertext[C]{
+		c1: enc.gen.MulBase(y),
+		c2: m.Add(curve.MulSecret[C](pk, y)),
+	}
+	u := curve.MulSecret[C](enc.secondGenerator(), y)
+
+	x := dleq.MakeWord[C](enc.gen.Generator(), ct.c1, enc.secondGenerator(), u)
+	proof, err := enc.validityNIZK(ct, label).Prove(x, dleq.Witness[C](y))
+	if err != nil {
+		return CCACiphertext[C]{}, fmt.Errorf("proving validity: %w", err)
+	}
+	return CCACiphertext[C]{
+		ct:    ct,
+		u:     u,
+		proof: proof,
+	}, nil
+}
+
+// DecryptCCA checks the validity of the ciphertext under the label and
+// decrypts it.
+func (enc *Cipher[C]) DecryptCCA(sk SecretKey[C], ct CCACiphertext[C], label []byte) ([]byte, error) {
+	if !enc.isValid(ct, label) {
+		return nil, fmt.Errorf("invalid ciphertext")
+	}
+	return enc.Decrypt(sk, ct.ct), nil
+}
+
+// PartialDecryptCCA checks the validity of the ciphertext under the label and
+// computes a decryption share. Trustees must not release shares for invalid
+// ciphertexts.
+func (enc *Cipher[C]) PartialDecryptCCA(
+	ks KeyShare[C],
+	ct CCACiphertext[C],
+	label []byte,
+) (DecryptionShare[C], error) {
+	if !enc.isValid(ct, label) {
+		return DecryptionShare[C]{}, fmt.Errorf("invalid ciphertext")
+	}
+	return enc.PartialDecrypt(ks, ct.ct)
+}
+
+// CombineCCA checks the validity of the ciphertext under the label and
+// decrypts it from the decryption shares like Combine.
+func (enc *Cipher[C]) CombineCCA(
+	tk ThresholdKey[C],
+	ct CCACiphertext[C],
+	label []byte,
+	shares []DecryptionShare[C],
+) ([]byte, error) {
+	if !enc.isValid(ct, label) {
+		return nil, fmt.Errorf("invalid ciphertext")
+	}
+	return enc.Combine(tk, ct.ct, shares)
+}
+
+func (enc *Cipher[C]) isValid(ct CCACiphertext[C], label []byte) bool {
+	x := dleq.MakeWord[C](enc.gen.Generator(), ct.ct.c1, enc.secondGenerator(), ct.u)
+	return enc.validityNIZK(ct.ct, label).Verify(x, ct.proof)
+}
+
+// secondGenerator returns the generator H, whose discrete logarithm with
+// respect to G is unknown.
+func (enc *Cipher[C]) secondGenerator() curve.Point[C] {
+	return enc.gen.HashToPoint(tdh2DST, nil)
+}
+
+// validityNIZK returns the NIZK for validity proofs. Its label binds the
+// proofs to the label of the ciphertext and to c2.
+func (enc *Cipher[C]) validityNIZK(ct Ciphertext[C], label []byte) nizk.NIZK[C, dleq.Protocol] {
+	l := binary.BigEndian.AppendUint64(append([]byte(nil), tdh2DST...), uint64(len(label)))
+	l = append(l, label...)
+	l = append(l, ct.c2.CompressedBytes()...)
+	return nizk.New[C, dleq.Protocol](
+		dleq.NewProver[C](enc.gen, enc.rnd),
+		dleq.NewVerifier[C](enc.gen, enc.rnd),
+		dleq.NewEncoder[C](enc.gen),
+		l,
+	)
+}
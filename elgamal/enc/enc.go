@@ -3,7 +3,6 @@ package enc
 import (
 	"fmt"
 	"io"
-	"math/big"
 
 	"github.com/matthiasgeihs/go-curve/curve"
 )
@@ -31,7 +30,7 @@ func (enc *Cipher[C]) KeyGen() (SecretKey[C], PubKey[C], error) {
 	if err != nil {
 		return nil, nil, fmt.Errorf("generating secret key: %w", err)
 	}
-	pk := enc.gen.Generator().Mul(sk)
+	pk := enc.gen.MulBase(sk)
 	return sk, pk, nil
 }
 
@@ -46,17 +45,15 @@ func (enc *Cipher[C]) Encrypt(pk PubKey[C], data []byte) (Ciphertext[C], error)
 		return Ciphertext[C]{}, fmt.Errorf("generating nonce: %w", err)
 	}
 
-	s := pk.Mul(y)
+	s := curve.MulSecret[C](pk, y)
 	return Ciphertext[C]{
-		c1: enc.gen.Generator().Mul(y),
+		c1: enc.gen.MulBase(y),
 		c2: m.Add(s),
 	}, nil
 }
 
 func (ciph *Cipher[C]) Decrypt(sk SecretKey[C], ct Ciphertext[C]) []byte {
-	qSubSk := new(big.Int).Sub(ciph.gen.GeneratorOrder(), sk.Int())
-	qSubSkScalar := ciph.gen.NewScalar(qSubSk)
-	sinv := ct.c1.Mul(qSubSkScalar)
-	m := ct.c2.Add(sinv)
+	s := curve.MulSecret[C](ct.c1, sk)
+	m := ct.c2.Sub(s)
 	return ciph.gen.DecodeFromPoint(m)
 }
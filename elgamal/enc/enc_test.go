@@ -3,10 +3,17 @@ package enc_test
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/json"
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/matthiasgeihs/go-curve/curve"
 	"github.com/matthiasgeihs/go-curve/curve/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve/p256"
+	"github.com/matthiasgeihs/go-curve/curve/p384"
+	"github.com/matthiasgeihs/go-curve/curve/p521"
+	"github.com/matthiasgeihs/go-curve/curve/ristretto255"
 	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
 	"github.com/matthiasgeihs/go-curve/elgamal/enc"
 )
@@ -27,6 +34,38 @@ func TestCipher_edwards25519(t *testing.T) {
 	testCipher(t, instance)
 }
 
+func TestCipher_ristretto255(t *testing.T) {
+	instance := enc.NewCipher[ristretto255.Curve](
+		ristretto255.NewGenerator(),
+		rand.Reader,
+	)
+	testCipher(t, instance)
+}
+
+func TestCipher_p256(t *testing.T) {
+	instance := enc.NewCipher[p256.Curve](
+		p256.NewGenerator(),
+		rand.Reader,
+	)
+	testCipher(t, instance)
+}
+
+func TestCipher_p384(t *testing.T) {
+	instance := enc.NewCipher[p384.Curve](
+		p384.NewGenerator(),
+		rand.Reader,
+	)
+	testCipher(t, instance)
+}
+
+func TestCipher_p521(t *testing.T) {
+	instance := enc.NewCipher[p521.Curve](
+		p521.NewGenerator(),
+		rand.Reader,
+	)
+	testCipher(t, instance)
+}
+
 func testCipher[C curve.Curve](t *testing.T, instance enc.Cipher[C]) {
 	sk, pk, err := instance.KeyGen()
 	if err != nil {
@@ -43,4 +82,534 @@ func testCipher[C curve.Curve](t *testing.T, instance enc.Cipher[C]) {
 	if !bytes.Equal(msg, msgDec) {
 		t.Error("Decrypted message not equal to encrypted message")
 	}
+
+	// Hybrid encryption supports plaintexts longer than a point encoding.
+	doc := bytes.Repeat([]byte("Hi, Singapore! "), 100)
+	ad := []byte("header")
+	hct, err := instance.EncryptHybrid(pk, doc, ad)
+	if err != nil {
+		t.Fatal(err)
+	}
+	docDec, err := instance.DecryptHybrid(sk, hct, ad)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(doc, docDec) {
+		t.Error("Decrypted document not equal to encrypted document")
+	}
+	if _, err := instance.DecryptHybrid(sk, hct, []byte("other")); err == nil {
+		t.Error("Decryption with different associated data should fail")
+	}
+	skOther, _, err := instance.KeyGen()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := instance.DecryptHybrid(skOther, hct, ad); err == nil {
+		t.Error("Decryption with different key should fail")
+	}
+}
+
+func TestLifted_secp256k1(t *testing.T) {
+	g := secp256k1.NewGenerator()
+	testLifted[secp256k1.Curve](t, g, enc.NewCipher[secp256k1.Curve](g, rand.Reader))
+}
+
+func TestLifted_ristretto255(t *testing.T) {
+	g := ristretto255.NewGenerator()
+	testLifted[ristretto255.Curve](t, g, enc.NewCipher[ristretto255.Curve](g, rand.Reader))
+}
+
+func testLifted[C curve.Curve](t *testing.T, g curve.Generator[C], instance enc.Cipher[C]) {
+	sk, pk, err := instance.KeyGen()
+	if err != nil {
+		t.Fatal(err)
+	}
+	const min, max = -1 << 16, 1 << 16
+	table := enc.NewDLogTable(g, 1<<9)
+
+	encrypt := func(m int64) enc.Ciphertext[C] {
+		ct, err := instance.EncryptInt(pk, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ct
+	}
+	decryptsTo := func(ct enc.Ciphertext[C], m int64) bool {
+		mDec, err := instance.DecryptInt(sk, ct, table, min, max)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return mDec == m
+	}
+
+	for _, m := range []int64{0, 1, -1, min, max, 12345} {
+		if !decryptsTo(encrypt(m), m) {
+			t.Errorf("decryption should equal %d", m)
+		}
+	}
+
+	a, b := encrypt(1200), encrypt(-34)
+	if !decryptsTo(a.Add(b), 1166) {
+		t.Error("Add should add plaintexts")
+	}
+	if !decryptsTo(b.ScalarMul(g.NewScalar(big.NewInt(5))), -170) {
+		t.Error("ScalarMul should multiply plaintext")
+	}
+	if !decryptsTo(a.Neg(), -1200) {
+		t.Error("Neg should negate plaintext")
+	}
+	aRand, err := instance.Rerandomize(pk, a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decryptsTo(aRand, 1200) {
+		t.Error("Rerandomize should preserve plaintext")
+	}
+
+	if _, err := instance.DecryptInt(sk, encrypt(max+1), table, min, max); err == nil {
+		t.Error("plaintext above range should not decrypt")
+	}
+	if _, err := instance.DecryptInt(sk, encrypt(5), table, 6, 100); err == nil {
+		t.Error("plaintext below range should not decrypt")
+	}
+	if m, err := instance.DecryptInt(sk, encrypt(7), table, 7, 7); err != nil || m != 7 {
+		t.Error("plaintext in singleton range should decrypt")
+	}
+}
+
+// TestDLogTable_extremeRange checks ranges whose number of giant steps is
+// close to the maximum of uint64.
+func TestDLogTable_extremeRange(t *testing.T) {
+	g := secp256k1.NewGenerator()
+	lift := func(m int64) curve.Point[secp256k1.Curve] {
+		return g.MulBase(g.NewScalar(new(big.Int).Mod(big.NewInt(m), g.GeneratorOrder())))
+	}
+	for _, size := range []uint64{1, 1 << 4} {
+		table := enc.NewDLogTable[secp256k1.Curve](g, size)
+		for _, tc := range []struct {
+			m, min, max int64
+			ok          bool
+		}{
+			{math.MinInt64 + 3, math.MinInt64, math.MaxInt64, true},
+			{math.MinInt64 + 20, math.MinInt64, math.MaxInt64, true},
+			{math.MaxInt64 - 1, math.MaxInt64 - 2, math.MaxInt64, true},
+			{math.MaxInt64 - 5, math.MaxInt64 - 2, math.MaxInt64, false},
+			{math.MinInt64, math.MinInt64, math.MinInt64, true},
+		} {
+			m, err := table.DLog(lift(tc.m), tc.min, tc.max)
+			if tc.ok && (err != nil || m != tc.m) {
+				t.Errorf("table size %d: DLog should find %d in [%d, %d]", size, tc.m, tc.min, tc.max)
+			}
+			if !tc.ok && err == nil {
+				t.Errorf("table size %d: DLog should not find %d in [%d, %d]", size, tc.m, tc.min, tc.max)
+			}
+		}
+	}
+}
+
+func TestThreshold_secp256k1(t *testing.T) {
+	g := secp256k1.NewGenerator()
+	testThreshold[secp256k1.Curve](t, g, enc.NewCipher[secp256k1.Curve](g, rand.Reader))
+}
+
+func TestThreshold_edwards25519(t *testing.T) {
+	g := edwards25519.NewGenerator()
+	testThreshold[edwards25519.Curve](t, g, enc.NewCipher[edwards25519.Curve](g, rand.Reader))
+}
+
+// distributedKeyGen runs the key generation among n parties.
+func distributedKeyGen[C curve.Curve](t *testing.T, instance enc.Cipher[C], threshold, n int) (
+	[]enc.Dealing[C],
+	[]enc.KeyShare[C],
+	enc.ThresholdKey[C],
+) {
+	dealings := make([]enc.Dealing[C], n)
+	commitments := make([]enc.Commitments[C], n)
+	for i := range dealings {
+		var err error
+		dealings[i], err = instance.Deal(i+1, threshold, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		commitments[i] = dealings[i].Commitments()
+	}
+	keyShares := make([]enc.KeyShare[C], n)
+	for j := range keyShares {
+		shares := make([]curve.Scalar[C], n)
+		for i, d := range dealings {
+			shares[i] = d.Share(j + 1)
+		}
+		var err error
+		keyShares[j], err = instance.NewKeyShare(j+1, commitments, shares)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	tk, err := instance.NewThresholdKey(n, commitments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dealings, keyShares, tk
+}
+
+func testThreshold[C curve.Curve](t *testing.T, g curve.Generator[C], instance enc.Cipher[C]) {
+	const threshold, n = 2, 3
+
+	dealings, keyShares, tk := distributedKeyGen(t, instance, threshold, n)
+	commitments := make([]enc.Commitments[C], n)
+	for i, d := range dealings {
+		commitments[i] = d.Commitments()
+	}
+	if _, err := instance.NewKeyShare(1, commitments, []curve.Scalar[C]{
+		dealings[0].Share(2), dealings[1].Share(1), dealings[2].Share(1),
+	}); err == nil {
+		t.Error("share for a different party should be rejected")
+	}
+
+	// A dealer sharing a polynomial of higher degree would raise the number
+	// of parties needed to decrypt.
+	long, err := instance.Deal(2, threshold+1, n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := instance.NewKeyShare(1, []enc.Commitments[C]{
+		commitments[0], long.Commitments(), commitments[2],
+	}, []curve.Scalar[C]{
+		dealings[0].Share(1), long.Share(1), dealings[2].Share(1),
+	}); err == nil {
+		t.Error("commitments of a polynomial of different degree should be rejected")
+	}
+
+	// A rushing dealer that sets its constant coefficient commitment to
+	// target - sum of the honest ones cannot prove knowledge of it. Its shares
+	// for the honest parties still pass the Feldman checks if fewer than
+	// threshold parties are honest, as here for party 1.
+	target := g.MulBase(g.One())
+	c0 := target.Sub(commitments[0].Points()[0])
+	forged := append([]curve.Point[C]{c0}, dealings[1].Commitments().Points()[1:]...)
+	forged[1] = forged[1].Add(dealings[1].Commitments().Points()[0]).Sub(c0)
+	forgedCommitments := []enc.Commitments[C]{
+		commitments[0],
+		enc.MakeCommitments(forged, commitments[1].Proof()),
+	}
+	forgedShares := []curve.Scalar[C]{dealings[0].Share(1), dealings[1].Share(1)}
+	if _, err := instance.NewKeyShare(1, forgedCommitments, forgedShares); err == nil {
+		t.Error("commitments with forged constant coefficient should be rejected")
+	}
+	if _, err := instance.NewThresholdKey(n, forgedCommitments); err == nil {
+		t.Error("commitments with forged constant coefficient should be rejected")
+	}
+	if _, err := instance.NewThresholdKey(n, []enc.Commitments[C]{commitments[1], commitments[0], commitments[2]}); err == nil {
+		t.Error("proof of knowledge of a different dealer should be rejected")
+	}
+	if _, err := instance.NewThresholdKey(n, []enc.Commitments[C]{commitments[0], commitments[1]}); err != nil {
+		t.Errorf("valid commitments should be accepted: %v", err)
+	}
+
+	// Key shares, the threshold key and decryption shares are sent to other
+	// processes in encoded form.
+	tk, err = instance.DecodeThresholdKey(transmit(t, instance.EncodeThresholdKey(tk)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for j, ks := range keyShares {
+		keyShares[j], err = instance.DecodeKeyShare(transmit(t, instance.EncodeKeyShare(ks)))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := instance.DecodeKeyShare(instance.EncodeThresholdKey(tk)); err == nil {
+		t.Error("threshold key should not decode as key share")
+	}
+
+	msg := []byte("Hi, Singapore!")
+	ct, err := instance.Encrypt(tk.PubKey(), msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shares := make([]enc.DecryptionShare[C], n)
+	for j, ks := range keyShares {
+		share, err := instance.PartialDecrypt(ks, ct)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shares[j], err = instance.DecodeDecryptionShare(transmit(t, instance.EncodeDecryptionShare(share)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if shares[j].Index() != ks.Index() {
+			t.Errorf("decoded share should have index %d, has %d", ks.Index(), shares[j].Index())
+		}
+	}
+	zeroIndex := instance.EncodeDecryptionShare(shares[0])
+	zeroIndex.Data = append([]byte{0, 0, 0, 0}, zeroIndex.Data[4:]...)
+	if _, err := instance.DecodeDecryptionShare(zeroIndex); err == nil {
+		t.Error("share with index 0 should be rejected")
+	}
+
+	for _, subset := range [][]int{{0, 1}, {1, 2}, {2, 0}} {
+		msgDec, err := instance.Combine(tk, ct, []enc.DecryptionShare[C]{shares[subset[0]], shares[subset[1]]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(msg, msgDec) {
+			t.Errorf("combined decryption should equal message for shares %v", subset)
+		}
+	}
+	if _, err := instance.Combine(tk, ct, shares[:1]); err == nil {
+		t.Error("a single share should not suffice")
+	}
+	if _, err := instance.Combine(enc.ThresholdKey[C]{}, ct, shares); err == nil {
+		t.Error("zero threshold key should be rejected")
+	}
+	if _, err := instance.Combine(tk, ct, []enc.DecryptionShare[C]{shares[0], shares[0]}); err == nil {
+		t.Error("duplicate shares should not count twice")
+	}
+
+	// Shares for a different ciphertext are ignored.
+	ctInt, err := instance.EncryptInt(tk.PubKey(), -42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherShare, err := instance.PartialDecrypt(keyShares[0], ctInt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := instance.Combine(tk, ct, []enc.DecryptionShare[C]{otherShare, shares[1]}); err == nil {
+		t.Error("share for a different ciphertext should be rejected")
+	}
+	table := enc.NewDLogTable(g, 1<<4)
+	if _, err := instance.CombineInt(tk, ctInt, []enc.DecryptionShare[C]{shares[1], otherShare}, table, -100, 100); err == nil {
+		t.Error("share for a different ciphertext should be rejected")
+	}
+	intShares := []enc.DecryptionShare[C]{otherShare}
+	for _, ks := range keyShares[1:] {
+		s, err := instance.PartialDecrypt(ks, ctInt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		intShares = append(intShares, s)
+	}
+	m, err := instance.CombineInt(tk, ctInt, intShares, table, -100, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m != -42 {
+		t.Errorf("combined decryption should equal -42, got %d", m)
+	}
+}
+
+func TestEncoding_secp256k1(t *testing.T) {
+	instance := enc.NewCipher[secp256k1.Curve](secp256k1.NewGenerator(), rand.Reader)
+	testEncoding(t, instance)
+}
+
+func TestEncoding_edwards25519(t *testing.T) {
+	instance := enc.NewCipher[edwards25519.Curve](edwards25519.NewGenerator(), rand.Reader)
+	testEncoding(t, instance)
+}
+
+func TestEncoding_p256(t *testing.T) {
+	instance := enc.NewCipher[p256.Curve](p256.NewGenerator(), rand.Reader)
+	testEncoding(t, instance)
+}
+
+// transmit sends e over the wire in both binary and JSON encoding.
+func transmit(t *testing.T, e enc.Encoded) enc.Encoded {
+	data, err := e.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var eBin enc.Encoded
+	if err := eBin.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if err := eBin.UnmarshalBinary(data[:2]); err == nil {
+		t.Error("should reject truncated input")
+	}
+	data, err = json.Marshal(eBin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var eJSON enc.Encoded
+	if err := json.Unmarshal(data, &eJSON); err != nil {
+		t.Fatal(err)
+	}
+	return eJSON
+}
+
+func testEncoding[C curve.Curve](t *testing.T, instance enc.Cipher[C]) {
+	sk, pk, err := instance.KeyGen()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("Hi, Singapore!")
+	ct, err := instance.Encrypt(pk, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hct, err := instance.EncryptHybrid(pk, msg, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkDec, err := instance.DecodePubKey(transmit(t, instance.EncodePubKey(pk)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	skDec, err := instance.DecodeSecretKey(transmit(t, instance.EncodeSecretKey(sk)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pkDec.Equal(pk) || !skDec.Equal(sk) {
+		t.Error("decoded keys should equal encoded keys")
+	}
+	ctDec, err := instance.DecodeCiphertext(transmit(t, instance.EncodeCiphertext(ct)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(msg, instance.Decrypt(skDec, ctDec)) {
+		t.Error("decoded ciphertext should decrypt to message")
+	}
+	hctDec, err := instance.DecodeHybridCiphertext(transmit(t, instance.EncodeHybridCiphertext(hct)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msgDec, err := instance.DecryptHybrid(skDec, hctDec, nil); err != nil || !bytes.Equal(msg, msgDec) {
+		t.Error("decoded hybrid ciphertext should decrypt to message")
+	}
+
+	// Homomorphic operations may yield the identity.
+	zero := ct.Add(ct.Neg())
+	if _, err := instance.DecodeCiphertext(transmit(t, instance.EncodeCiphertext(zero))); err != nil {
+		t.Error("ciphertext containing the identity should decode")
+	}
+
+	e := instance.EncodePubKey(pk)
+	if _, err := instance.DecodeSecretKey(e); err == nil {
+		t.Error("should reject type mismatch")
+	}
+	other := e
+	other.Curve = "other"
+	if _, err := instance.DecodePubKey(other); err == nil {
+		t.Error("should reject curve mismatch")
+	}
+	other = e
+	other.Version = 2
+	if _, err := instance.DecodePubKey(other); err == nil {
+		t.Error("should reject unknown version")
+	}
+	other = instance.EncodeCiphertext(ct)
+	other.Data = append(other.Data, 0)
+	if _, err := instance.DecodeCiphertext(other); err == nil {
+		t.Error("should reject trailing data")
+	}
+
+	// Points in another valid encoding, such as uncompressed SEC1, would make
+	// the encodings malleable.
+	if b := pk.Bytes(); !bytes.Equal(b, pk.CompressedBytes()) {
+		other = e
+		other.Data = b
+		if _, err := instance.DecodePubKey(other); err == nil {
+			t.Error("should reject non-canonical public key")
+		}
+		other = instance.EncodeHybridCiphertext(hct)
+		other.Data = append(append([]byte{byte(len(b))}, b...), other.Data[1+other.Data[0]:]...)
+		if _, err := instance.DecodeHybridCiphertext(other); err == nil {
+			t.Error("should reject non-canonical point in ciphertext")
+		}
+	}
+}
+
+func TestCCA_secp256k1(t *testing.T) {
+	g := secp256k1.NewGenerator()
+	testCCA[secp256k1.Curve](t, g, enc.NewCipher[secp256k1.Curve](g, rand.Reader))
+}
+
+func TestCCA_ristretto255(t *testing.T) {
+	g := ristretto255.NewGenerator()
+	testCCA[ristretto255.Curve](t, g, enc.NewCipher[ristretto255.Curve](g, rand.Reader))
+}
+
+func testCCA[C curve.Curve](t *testing.T, g curve.Generator[C], instance enc.Cipher[C]) {
+	sk, pk, err := instance.KeyGen()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := []byte("Hi, Singapore!")
+	label := []byte("label")
+	ct, err := instance.EncryptCCA(pk, msg, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The ciphertext is sent over the wire.
+	data, err := json.Marshal(instance.EncodeCCACiphertext(ct))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e enc.Encoded
+	if err := json.Unmarshal(data, &e); err != nil {
+		t.Fatal(err)
+	}
+	ct, err = instance.DecodeCCACiphertext(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msgDec, err := instance.DecryptCCA(sk, ct, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(msg, msgDec) {
+		t.Error("decryption should equal message")
+	}
+	if _, err := instance.DecryptCCA(sk, ct, []byte("other")); err == nil {
+		t.Error("ciphertext should not decrypt under a different label")
+	}
+
+	// Adding a point to c2 invalidates the ciphertext.
+	mauled := instance.EncodeCCACiphertext(ct)
+	l1 := int(mauled.Data[0])
+	l2 := int(mauled.Data[1+l1])
+	c2, err := g.DecodePoint(mauled.Data[2+l1 : 2+l1+l2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	copy(mauled.Data[2+l1:], c2.Add(g.Generator()).CompressedBytes())
+	ctMauled, err := instance.DecodeCCACiphertext(mauled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := instance.DecryptCCA(sk, ctMauled, label); err == nil {
+		t.Error("mauled ciphertext should be rejected")
+	}
+
+	// Threshold decryption.
+	_, keyShares, tk := distributedKeyGen(t, instance, 2, 3)
+	ct, err = instance.EncryptCCA(tk.PubKey(), msg, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var shares []enc.DecryptionShare[C]
+	for _, ks := range keyShares[1:] {
+		if _, err := instance.PartialDecryptCCA(ks, ct, []byte("other")); err == nil {
+			t.Error("trustee should refuse ciphertext under a different label")
+		}
+		s, err := instance.PartialDecryptCCA(ks, ct, label)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shares = append(shares, s)
+	}
+	msgDec, err = instance.CombineCCA(tk, ct, label, shares)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(msg, msgDec) {
+		t.Error("combined decryption should equal message")
+	}
+	if _, err := instance.CombineCCA(tk, ct, []byte("other"), shares); err == nil {
+		t.Error("combiner should refuse ciphertext under a different label")
+	}
 }
@@ -0,0 +1,371 @@
+package enc
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/sigma/nizk"
+)
+
+// encodingVersion is the version of the encoding.
+const encodingVersion = 1
+
+// Types of encoded objects.
+const (
+	typePubKey           = "public-key"
+	typeSecretKey        = "secret-key"
+	typeCiphertext       = "ciphertext"
+	typeHybridCiphertext = "hybrid-ciphertext"
+	typeCCACiphertext    = "cca-ciphertext"
+	typeKeyShare         = "key-share"
+	typeThresholdKey     = "threshold-key"
+	typeDecryptionShare  = "decryption-share"
+)
+
+// Check that type implements interfaces.
+var _ encoding.BinaryMarshaler = Encoded{}
+var _ encoding.BinaryUnmarshaler = (*Encoded)(nil)
+
+// Encoded is the serialized form of a key, a ciphertext or a share. It
+// identifies the curve and the type of the object, so that objects are never
+// decoded as something else. Points are encoded in canonical compressed form
+// and scalars in canonical fixed-length form. Encoded has a binary encoding
+// and, through its field tags, a JSON encoding, in which Data is base64
+// encoded.
+type Encoded struct {
+	Version int    `json:"version"`
+	Curve   string `json:"curve"`
+	Type    string `json:"type"`
+	Data    []byte `json:"data"`
+}
+
+// MarshalBinary encodes the object as the version byte, followed by the
+// length-prefixed curve and type identifiers and the data.
+func (e Encoded) MarshalBinary() ([]byte, error) {
+	if e.Version < 0 || e.Version > 0xff {
+		return nil, fmt.Errorf("invalid version: %d", e.Version)
+	} else if len(e.Curve) > 0xff || len(e.Type) > 0xff {
+		return nil, fmt.Errorf("identifier too long")
+	}
+	data := []byte{byte(e.Version)}
+	data = appendPrefixed(data, []byte(e.Curve))
+	data = appendPrefixed(data, []byte(e.Type))
+	return append(data, e.Data...), nil
+}
+
+func (e *Encoded) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("empty input")
+	}
+	version := int(data[0])
+	c, data, err := readPrefixed(data[1:])
+	if err != nil {
+		return fmt.Errorf("reading curve: %w", err)
+	}
+	t, data, err := readPrefixed(data)
+	if err != nil {
+		return fmt.Errorf("reading type: %w", err)
+	}
+	*e = Encoded{
+		Version: version,
+		Curve:   string(c),
+		Type:    string(t),
+		Data:    append([]byte(nil), data...),
+	}
+	return nil
+}
+
+func (enc *Cipher[C]) EncodePubKey(pk PubKey[C]) Encoded {
+	return enc.encoded(typePubKey, pk.CompressedBytes())
+}
+
+func (enc *Cipher[C]) DecodePubKey(e Encoded) (PubKey[C], error) {
+	data, err := enc.checkEncoded(e, typePubKey)
+	if err != nil {
+		return nil, err
+	}
+	return enc.decodePoint(data)
+}
+
+func (enc *Cipher[C]) EncodeSecretKey(sk SecretKey[C]) Encoded {
+	return enc.encoded(typeSecretKey, sk.Bytes())
+}
+
+func (enc *Cipher[C]) DecodeSecretKey(e Encoded) (SecretKey[C], error) {
+	data, err := enc.checkEncoded(e, typeSecretKey)
+	if err != nil {
+		return nil, err
+	}
+	sk, err := enc.gen.DecodeScalar(data)
+	if err != nil {
+		return nil, fmt.Errorf("decoding scalar: %w", err)
+	} else if sk.IsZero() {
+		return nil, fmt.Errorf("zero secret key")
+	}
+	return sk, nil
+}
+
+// EncodeCiphertext encodes the ciphertext as the length-prefixed points c1
+// and c2.
+func (enc *Cipher[C]) EncodeCiphertext(ct Ciphertext[C]) Encoded {
+	data := appendPrefixed(nil, ct.c1.CompressedBytes())
+	data = appendPrefixed(data, ct.c2.CompressedBytes())
+	return enc.encoded(typeCiphertext, data)
+}
+
+func (enc *Cipher[C]) DecodeCiphertext(e Encoded) (Ciphertext[C], error) {
+	data, err := enc.checkEncoded(e, typeCiphertext)
+	if err != nil {
+		return Ciphertext[C]{}, err
+	}
+	c1, data, err := enc.readPoint(data)
+	if err != nil {
+		return Ciphertext[C]{}, fmt.Errorf("reading c1: %w", err)
+	}
+	c2, data, err := enc.readPoint(data)
+	if err != nil {
+		return Ciphertext[C]{}, fmt.Errorf("reading c2: %w", err)
+	} else if len(data) != 0 {
+		return Ciphertext[C]{}, fmt.Errorf("unexpected trailing data")
+	}
+	return Ciphertext[C]{
+		c1: c1,
+		c2: c2,
+	}, nil
+}
+
+// EncodeHybridCiphertext encodes the ciphertext as the length-prefixed
+// encapsulation c1 followed by the AEAD ciphertext.
+func (enc *Cipher[C]) EncodeHybridCiphertext(ct HybridCiphertext[C]) Encoded {
+	data := appendPrefixed(nil, ct.c1.CompressedBytes())
+	return enc.encoded(typeHybridCiphertext, append(data, ct.sealed...))
+}
+
+func (enc *Cipher[C]) DecodeHybridCiphertext(e Encoded) (HybridCiphertext[C], error) {
+	data, err := enc.checkEncoded(e, typeHybridCiphertext)
+	if err != nil {
+		return HybridCiphertext[C]{}, err
+	}
+	c1, data, err := enc.readPoint(data)
+	if err != nil {
+		return HybridCiphertext[C]{}, fmt.Errorf("reading c1: %w", err)
+	}
+	return HybridCiphertext[C]{
+		c1:     c1,
+		sealed: append([]byte(nil), data...),
+	}, nil
+}
+
+// EncodeCCACiphertext encodes the ciphertext as the length-prefixed points
+// c1, c2 and u followed by the validity proof.
+func (enc *Cipher[C]) EncodeCCACiphertext(ct CCACiphertext[C]) Encoded {
+	data := appendPrefixed(nil, ct.ct.c1.CompressedBytes())
+	data = appendPrefixed(data, ct.ct.c2.CompressedBytes())
+	data = appendPrefixed(data, ct.u.CompressedBytes())
+	return enc.encoded(typeCCACiphertext, append(data, ct.proof...))
+}
+
+func (enc *Cipher[C]) DecodeCCACiphertext(e Encoded) (CCACiphertext[C], error) {
+	data, err := enc.checkEncoded(e, typeCCACiphertext)
+	if err != nil {
+		return CCACiphertext[C]{}, err
+	}
+	var points [3]curve.Point[C]
+	for i := range points {
+		points[i], data, err = enc.readPoint(data)
+		if err != nil {
+			return CCACiphertext[C]{}, fmt.Errorf("reading point %d: %w", i, err)
+		}
+	}
+	return CCACiphertext[C]{
+		ct: Ciphertext[C]{
+			c1: points[0],
+			c2: points[1],
+		},
+		u:     points[2],
+		proof: append(nizk.Proof(nil), data...),
+	}, nil
+}
+
+// EncodeKeyShare encodes the key share as the 4-byte index followed by the
+// secret scalar.
+func (enc *Cipher[C]) EncodeKeyShare(ks KeyShare[C]) Encoded {
+	data := binary.BigEndian.AppendUint32(nil, uint32(ks.index))
+	return enc.encoded(typeKeyShare, append(data, ks.sk.Bytes()...))
+}
+
+func (enc *Cipher[C]) DecodeKeyShare(e Encoded) (KeyShare[C], error) {
+	data, err := enc.checkEncoded(e, typeKeyShare)
+	if err != nil {
+		return KeyShare[C]{}, err
+	}
+	index, data, err := readIndex(data)
+	if err != nil {
+		return KeyShare[C]{}, err
+	}
+	sk, err := enc.gen.DecodeScalar(data)
+	if err != nil {
+		return KeyShare[C]{}, fmt.Errorf("decoding scalar: %w", err)
+	}
+	return KeyShare[C]{
+		index: index,
+		sk:    sk,
+	}, nil
+}
+
+// EncodeThresholdKey encodes the threshold key as the 4-byte threshold,
+// followed by the length-prefixed public key and verification keys.
+func (enc *Cipher[C]) EncodeThresholdKey(tk ThresholdKey[C]) Encoded {
+	data := binary.BigEndian.AppendUint32(nil, uint32(tk.t))
+	data = appendPrefixed(data, tk.pk.CompressedBytes())
+	for _, vk := range tk.vks {
+		data = appendPrefixed(data, vk.CompressedBytes())
+	}
+	return enc.encoded(typeThresholdKey, data)
+}
+
+func (enc *Cipher[C]) DecodeThresholdKey(e Encoded) (ThresholdKey[C], error) {
+	data, err := enc.checkEncoded(e, typeThresholdKey)
+	if err != nil {
+		return ThresholdKey[C]{}, err
+	}
+	t, data, err := readIndex(data)
+	if err != nil {
+		return ThresholdKey[C]{}, fmt.Errorf("reading threshold: %w", err)
+	}
+	b, data, err := readPrefixed(data)
+	if err != nil {
+		return ThresholdKey[C]{}, fmt.Errorf("reading public key: %w", err)
+	}
+	pk, err := enc.decodePoint(b)
+	if err != nil {
+		return ThresholdKey[C]{}, fmt.Errorf("reading public key: %w", err)
+	}
+	var vks []curve.Point[C]
+	for len(data) != 0 {
+		var vk curve.Point[C]
+		vk, data, err = enc.readPoint(data)
+		if err != nil {
+			return ThresholdKey[C]{}, fmt.Errorf("reading verification key %d: %w", len(vks)+1, err)
+		}
+		vks = append(vks, vk)
+	}
+	if t > len(vks) {
+		return ThresholdKey[C]{}, fmt.Errorf("invalid threshold: %d of %d", t, len(vks))
+	}
+	return ThresholdKey[C]{
+		t:   t,
+		pk:  pk,
+		vks: vks,
+	}, nil
+}
+
+// EncodeDecryptionShare encodes the decryption share as the 4-byte index,
+// followed by the length-prefixed partial decryption and the proof.
+func (enc *Cipher[C]) EncodeDecryptionShare(s DecryptionShare[C]) Encoded {
+	data := binary.BigEndian.AppendUint32(nil, uint32(s.index))
+	data = appendPrefixed(data, s.d.CompressedBytes())
+	return enc.encoded(typeDecryptionShare, append(data, s.proof...))
+}
+
+func (enc *Cipher[C]) DecodeDecryptionShare(e Encoded) (DecryptionShare[C], error) {
+	data, err := enc.checkEncoded(e, typeDecryptionShare)
+	if err != nil {
+		return DecryptionShare[C]{}, err
+	}
+	index, data, err := readIndex(data)
+	if err != nil {
+		return DecryptionShare[C]{}, err
+	}
+	d, data, err := enc.readPoint(data)
+	if err != nil {
+		return DecryptionShare[C]{}, fmt.Errorf("reading partial decryption: %w", err)
+	}
+	return DecryptionShare[C]{
+		index: index,
+		d:     d,
+		proof: append(nizk.Proof(nil), data...),
+	}, nil
+}
+
+func (enc *Cipher[C]) encoded(typ string, data []byte) Encoded {
+	return Encoded{
+		Version: encodingVersion,
+		Curve:   enc.gen.Name(),
+		Type:    typ,
+		Data:    data,
+	}
+}
+
+// checkEncoded checks the version, the curve and the type of the object and
+// returns its data.
+func (enc *Cipher[C]) checkEncoded(e Encoded, typ string) ([]byte, error) {
+	if e.Version != encodingVersion {
+		return nil, fmt.Errorf("unsupported version: %d", e.Version)
+	} else if e.Curve != enc.gen.Name() {
+		return nil, fmt.Errorf("curve mismatch: %s", e.Curve)
+	} else if e.Type != typ {
+		return nil, fmt.Errorf("type mismatch: %s", e.Type)
+	}
+	return e.Data, nil
+}
+
+// readPoint reads a length-prefixed point. Unlike keys, ciphertexts resulting
+// from homomorphic operations may contain the identity.
+func (enc *Cipher[C]) readPoint(data []byte) (curve.Point[C], []byte, error) {
+	b, data, err := readPrefixed(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if id := enc.gen.Identity(); bytes.Equal(b, id.CompressedBytes()) {
+		return id, data, nil
+	}
+	p, err := enc.decodePoint(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	return p, data, nil
+}
+
+// decodePoint decodes a point and checks that b is its compressed encoding.
+// Backends also accept uncompressed encodings, which would make the encodings
+// malleable.
+func (enc *Cipher[C]) decodePoint(b []byte) (curve.Point[C], error) {
+	p, err := enc.gen.DecodePoint(b)
+	if err != nil {
+		return nil, fmt.Errorf("decoding point: %w", err)
+	} else if !bytes.Equal(b, p.CompressedBytes()) {
+		return nil, fmt.Errorf("non-canonical point encoding")
+	}
+	return p, nil
+}
+
+// appendPrefixed appends b prefixed with its length, which must be less than
+// 256.
+func appendPrefixed(data, b []byte) []byte {
+	data = append(data, byte(len(b)))
+	return append(data, b...)
+}
+
+// readIndex reads a 4-byte index, which must be positive.
+func readIndex(data []byte) (int, []byte, error) {
+	if len(data) < 4 {
+		return 0, nil, fmt.Errorf("input too short")
+	}
+	i := binary.BigEndian.Uint32(data)
+	if i < 1 || i > 1<<31-1 {
+		return 0, nil, fmt.Errorf("invalid index: %d", i)
+	}
+	return int(i), data[4:], nil
+}
+
+func readPrefixed(data []byte) ([]byte, []byte, error) {
+	if len(data) == 0 || len(data) < 1+int(data[0]) {
+		return nil, nil, fmt.Errorf("input too short")
+	}
+	l := int(data[0])
+	return data[1 : 1+l], data[1+l:], nil
+}
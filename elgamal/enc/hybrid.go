@@ -0,0 +1,60 @@
+package enc
+
+import (
+	"fmt"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/internal/dem"
+)
+
+// kdfDST is the domain separation tag of the hybrid key derivation.
+var kdfDST = []byte("go-curve-elgamal-hybrid-v1")
+
+// HybridCiphertext consists of the ElGamal key encapsulation c1 = y*G and the
+// AEAD encryption of the payload under a key derived from y*pk.
+type HybridCiphertext[C curve.Curve] struct {
+	c1     curve.Point[C]
+	sealed []byte
+}
+
+// EncryptHybrid encrypts data of arbitrary length to pk using AES-256-GCM
+// under a key encapsulated with ElGamal. The associated data ad is
+// authenticated but not encrypted.
+func (enc *Cipher[C]) EncryptHybrid(pk PubKey[C], data, ad []byte) (HybridCiphertext[C], error) {
+	y, err := enc.gen.RandomScalar(enc.rnd)
+	if err != nil {
+		return HybridCiphertext[C]{}, fmt.Errorf("generating nonce: %w", err)
+	}
+	c1 := enc.gen.MulBase(y)
+	s := curve.MulSecret[C](pk, y)
+
+	aead, err := newAEAD(c1, s)
+	if err != nil {
+		return HybridCiphertext[C]{}, err
+	}
+	return HybridCiphertext[C]{
+		c1:     c1,
+		sealed: aead.Seal(nil, data, ad),
+	}, nil
+}
+
+// DecryptHybrid decrypts the ciphertext. It fails if the ciphertext or the
+// associated data have been modified.
+func (enc *Cipher[C]) DecryptHybrid(sk SecretKey[C], ct HybridCiphertext[C], ad []byte) ([]byte, error) {
+	s := curve.MulSecret[C](ct.c1, sk)
+	aead, err := newAEAD(ct.c1, s)
+	if err != nil {
+		return nil, err
+	}
+	data, err := aead.Open(ct.sealed, ad)
+	if err != nil {
+		return nil, fmt.Errorf("opening ciphertext: %w", err)
+	}
+	return data, nil
+}
+
+// newAEAD derives the symmetric key from the encapsulation and the shared
+// point.
+func newAEAD[C curve.Curve](c1, s curve.Point[C]) (dem.AEAD, error) {
+	return dem.NewAEAD(kdfDST, c1.CompressedBytes(), s.CompressedBytes())
+}
@@ -0,0 +1,134 @@
+package enc
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+)
+
+// EncryptInt encrypts the integer m in the exponent as (y*G, m*G + y*pk).
+// Ciphertexts of this form are additively homomorphic, but decryption
+// requires solving a discrete logarithm and is only feasible for small m.
+func (enc *Cipher[C]) EncryptInt(pk PubKey[C], m int64) (Ciphertext[C], error) {
+	y, err := enc.gen.RandomScalar(enc.rnd)
+	if err != nil {
+		return Ciphertext[C]{}, fmt.Errorf("generating nonce: %w", err)
+	}
+
+	s := curve.MulSecret[C](pk, y)
+	return Ciphertext[C]{
+		c1: enc.gen.MulBase(y),
+		c2: enc.gen.MulBase(intScalar(enc.gen, m)).Add(s),
+	}, nil
+}
+
+// DecryptInt decrypts a ciphertext created by EncryptInt, or by homomorphic
+// operations on such ciphertexts, and returns the plaintext in [min, max].
+func (enc *Cipher[C]) DecryptInt(
+	sk SecretKey[C],
+	ct Ciphertext[C],
+	table *DLogTable[C],
+	min, max int64,
+) (int64, error) {
+	s := curve.MulSecret[C](ct.c1, sk)
+	return table.DLog(ct.c2.Sub(s), min, max)
+}
+
+// Add returns an encryption of the sum of the plaintexts.
+func (ct Ciphertext[C]) Add(other Ciphertext[C]) Ciphertext[C] {
+	return Ciphertext[C]{
+		c1: ct.c1.Add(other.c1),
+		c2: ct.c2.Add(other.c2),
+	}
+}
+
+// ScalarMul returns an encryption of the plaintext multiplied by k.
+func (ct Ciphertext[C]) ScalarMul(k curve.Scalar[C]) Ciphertext[C] {
+	return Ciphertext[C]{
+		c1: ct.c1.Mul(k),
+		c2: ct.c2.Mul(k),
+	}
+}
+
+// Neg returns an encryption of the negated plaintext.
+func (ct Ciphertext[C]) Neg() Ciphertext[C] {
+	return Ciphertext[C]{
+		c1: ct.c1.Neg(),
+		c2: ct.c2.Neg(),
+	}
+}
+
+// Rerandomize returns a fresh encryption of the same plaintext by adding an
+// encryption of zero.
+func (enc *Cipher[C]) Rerandomize(pk PubKey[C], ct Ciphertext[C]) (Ciphertext[C], error) {
+	y, err := enc.gen.RandomScalar(enc.rnd)
+	if err != nil {
+		return Ciphertext[C]{}, fmt.Errorf("generating nonce: %w", err)
+	}
+	return Ciphertext[C]{
+		c1: ct.c1.Add(enc.gen.MulBase(y)),
+		c2: ct.c2.Add(curve.MulSecret[C](pk, y)),
+	}, nil
+}
+
+// DLogTable holds the baby steps j*G for j < size of a baby-step giant-step
+// search. Solving a discrete logarithm in an interval of width w takes about
+// w/size giant steps, so the table should have about sqrt(w) entries. A table
+// can be reused across decryptions.
+type DLogTable[C curve.Curve] struct {
+	gen  curve.Generator[C]
+	size uint64
+	// table maps the encodings of j*G to j.
+	table map[string]uint64
+}
+
+func NewDLogTable[C curve.Curve](gen curve.Generator[C], size uint64) *DLogTable[C] {
+	if size == 0 {
+		size = 1
+	}
+	table := make(map[string]uint64, size)
+	p := gen.Identity()
+	for j := uint64(0); j < size; j++ {
+		table[string(p.CompressedBytes())] = j
+		p = p.Add(gen.Generator())
+	}
+	return &DLogTable[C]{
+		gen:   gen,
+		size:  size,
+		table: table,
+	}
+}
+
+// DLog finds m in [min, max] with m*G = p.
+func (t *DLogTable[C]) DLog(p curve.Point[C], min, max int64) (int64, error) {
+	if min > max {
+		return 0, fmt.Errorf("invalid range: [%d, %d]", min, max)
+	}
+	// Search for m - min in [0, width].
+	width := uint64(max) - uint64(min)
+	p = p.Sub(t.gen.MulBase(intScalar(t.gen, min)))
+	giant := t.gen.MulBase(t.gen.NewScalar(new(big.Int).SetUint64(t.size))).Neg()
+	// The loop runs for i = 0, ..., steps with the exit condition at the end,
+	// as steps+1 overflows for the full range and a table of size 1.
+	steps := width / t.size
+	for i := uint64(0); ; i++ {
+		if j, ok := t.table[string(p.CompressedBytes())]; ok {
+			// i*size <= width, so the comparison does not overflow.
+			if j > width-i*t.size {
+				break
+			}
+			return min + int64(i*t.size+j), nil
+		}
+		if i == steps {
+			break
+		}
+		p = p.Add(giant)
+	}
+	return 0, fmt.Errorf("discrete logarithm out of range")
+}
+
+// intScalar maps m to m mod the group order.
+func intScalar[C curve.Curve](gen curve.Generator[C], m int64) curve.Scalar[C] {
+	return gen.NewScalar(new(big.Int).Mod(big.NewInt(m), gen.GeneratorOrder()))
+}
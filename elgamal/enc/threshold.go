@@ -0,0 +1,338 @@
+package enc
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/internal/shamir"
+	"github.com/matthiasgeihs/go-curve/sigma/dleq"
+	"github.com/matthiasgeihs/go-curve/sigma/dlog"
+	"github.com/matthiasgeihs/go-curve/sigma/nizk"
+)
+
+// shareDST is the label of the proofs of correct partial decryption.
+var shareDST = []byte("go-curve-elgamal-threshold-v1")
+
+// dealDST is the label of the dealers' proofs of knowledge.
+var dealDST = []byte("go-curve-elgamal-threshold-deal-v1")
+
+// Dealing is a party's contribution to the generation of a t-out-of-n
+// sharing of the secret key, following the joint Feldman protocol of Pedersen,
+// "A Threshold Cryptosystem without a Trusted Party", EUROCRYPT 1991, so that
+// no party ever holds the full key. It consists of Feldman commitments to a
+// random polynomial f of degree t-1 and the shares f(1), ..., f(n). Each party
+// deals, broadcasts its commitments and sends share j privately to party j,
+// which checks it using NewKeyShare.
+//
+// Without further measures, a rushing dealer could choose its constant
+// coefficient commitment depending on those of the honest dealers and thereby
+// learn the joint secret key if fewer than t parties are honest. Each dealer
+// therefore proves knowledge of its constant coefficient, bound to its index,
+// so that its contribution to the key is independent of the others.
+type Dealing[C curve.Curve] struct {
+	commitments Commitments[C]
+	shares      []curve.Scalar[C]
+}
+
+// Commitments are the Feldman commitments of a dealer together with the proof
+// of knowledge of the discrete logarithm of the first commitment.
+type Commitments[C curve.Curve] struct {
+	points []curve.Point[C]
+	proof  nizk.Proof
+}
+
+func MakeCommitments[C curve.Curve](points []curve.Point[C], proof nizk.Proof) Commitments[C] {
+	return Commitments[C]{
+		points: points,
+		proof:  proof,
+	}
+}
+
+// Points returns the commitments to the coefficients, starting with the
+// constant coefficient.
+func (c Commitments[C]) Points() []curve.Point[C] {
+	return c.points
+}
+
+// Proof returns the proof of knowledge of the constant coefficient.
+func (c Commitments[C]) Proof() nizk.Proof {
+	return c.proof
+}
+
+// Deal creates the dealing of dealer i, starting at 1.
+func (enc *Cipher[C]) Deal(i, t, n int) (Dealing[C], error) {
+	if t < 1 || t > n {
+		return Dealing[C]{}, fmt.Errorf("invalid threshold: %d of %d", t, n)
+	}
+	if i < 1 || i > n {
+		return Dealing[C]{}, fmt.Errorf("invalid dealer index: %d", i)
+	}
+	coeffs, err := shamir.RandomPolynomial(enc.gen, enc.rnd, t)
+	if err != nil {
+		return Dealing[C]{}, fmt.Errorf("generating polynomial: %w", err)
+	}
+	points := make([]curve.Point[C], t)
+	for k, c := range coeffs {
+		points[k] = enc.gen.MulBase(c)
+	}
+	proof, err := enc.dealNIZK(i).Prove(dlog.Word[C](points[0]), dlog.Witness[C](coeffs[0]))
+	if err != nil {
+		return Dealing[C]{}, fmt.Errorf("proving knowledge of constant coefficient: %w", err)
+	}
+	shares := make([]curve.Scalar[C], n)
+	for j := range shares {
+		shares[j] = shamir.Eval(enc.gen, coeffs, j+1)
+	}
+	return Dealing[C]{
+		commitments: MakeCommitments(points, proof),
+		shares:      shares,
+	}, nil
+}
+
+// Commitments returns the commitments to the coefficients and the proof of
+// knowledge, which are broadcast to all parties.
+func (d Dealing[C]) Commitments() Commitments[C] {
+	return d.commitments
+}
+
+// Share returns the share for party j, starting at 1, which must be sent to
+// that party privately.
+func (d Dealing[C]) Share(j int) curve.Scalar[C] {
+	return d.shares[j-1]
+}
+
+// KeyShare is a party's share of the secret key.
+type KeyShare[C curve.Curve] struct {
+	index int
+	sk    curve.Scalar[C]
+}
+
+// NewKeyShare checks the commitments of the dealers 1, ..., len(commitments)
+// and the shares received by party j against them, and sums up the shares to
+// the party's key share. As in NewThresholdKey, the threshold t is the number
+// of commitments of the first dealer, and all dealers must commit to
+// polynomials of degree t-1.
+func (enc *Cipher[C]) NewKeyShare(
+	j int,
+	commitments []Commitments[C],
+	shares []curve.Scalar[C],
+) (KeyShare[C], error) {
+	if len(commitments) != len(shares) {
+		return KeyShare[C]{}, fmt.Errorf("inconsistent lengths")
+	}
+	if len(commitments) == 0 {
+		return KeyShare[C]{}, fmt.Errorf("no dealings")
+	}
+	t := len(commitments[0].points)
+	sk := enc.gen.Zero()
+	for i, s := range shares {
+		if len(commitments[i].points) != t {
+			return KeyShare[C]{}, fmt.Errorf("dealer %d: invalid number of commitments: %d", i+1, len(commitments[i].points))
+		}
+		if err := enc.verifyCommitments(i+1, commitments[i]); err != nil {
+			return KeyShare[C]{}, err
+		}
+		if !enc.gen.MulBase(s).Equal(shamir.EvalCommitments(enc.gen, commitments[i].points, j)) {
+			return KeyShare[C]{}, fmt.Errorf("invalid share from dealer %d", i+1)
+		}
+		sk = sk.Add(s)
+	}
+	return KeyShare[C]{
+		index: j,
+		sk:    sk,
+	}, nil
+}
+
+// Index returns the index of the party holding the share, starting at 1.
+func (ks KeyShare[C]) Index() int {
+	return ks.index
+}
+
+// SecretKey returns the party's share of the secret key, for use with other
+// schemes under the same key. It must be kept secret.
+func (ks KeyShare[C]) SecretKey() SecretKey[C] {
+	return ks.sk
+}
+
+// ThresholdKey is the public part of a shared key. It consists of the joint
+// public key and the verification keys sk_j*G of the parties.
+type ThresholdKey[C curve.Curve] struct {
+	t   int
+	pk  PubKey[C]
+	vks []curve.Point[C]
+}
+
+// NewThresholdKey checks the commitments of the dealers 1, ...,
+// len(commitments) and computes the public key for n parties from them.
+func (enc *Cipher[C]) NewThresholdKey(n int, commitments []Commitments[C]) (ThresholdKey[C], error) {
+	if len(commitments) == 0 {
+		return ThresholdKey[C]{}, fmt.Errorf("no dealings")
+	}
+	t := len(commitments[0].points)
+	if t < 1 || t > n {
+		return ThresholdKey[C]{}, fmt.Errorf("invalid threshold: %d of %d", t, n)
+	}
+	pk := enc.gen.Identity()
+	vks := make([]curve.Point[C], n)
+	for j := range vks {
+		vks[j] = enc.gen.Identity()
+	}
+	for i, c := range commitments {
+		if len(c.points) != t {
+			return ThresholdKey[C]{}, fmt.Errorf("dealer %d: invalid number of commitments: %d", i+1, len(c.points))
+		}
+		if err := enc.verifyCommitments(i+1, c); err != nil {
+			return ThresholdKey[C]{}, err
+		}
+		pk = pk.Add(c.points[0])
+		for j := range vks {
+			vks[j] = vks[j].Add(shamir.EvalCommitments(enc.gen, c.points, j+1))
+		}
+	}
+	return ThresholdKey[C]{
+		t:   t,
+		pk:  pk,
+		vks: vks,
+	}, nil
+}
+
+// PubKey returns the joint public key to encrypt to.
+func (tk ThresholdKey[C]) PubKey() PubKey[C] {
+	return tk.pk
+}
+
+// Threshold returns the number of parties needed to decrypt.
+func (tk ThresholdKey[C]) Threshold() int {
+	return tk.t
+}
+
+// VerificationKeys returns the verification keys of the parties 1 to n.
+func (tk ThresholdKey[C]) VerificationKeys() []curve.Point[C] {
+	return tk.vks
+}
+
+// DecryptionShare is a partial decryption sk_j*c1 together with a proof
+// that it has the same discrete logarithm as the verification key of party j.
+type DecryptionShare[C curve.Curve] struct {
+	index int
+	d     curve.Point[C]
+	proof nizk.Proof
+}
+
+// Index returns the index of the party that created the share.
+func (s DecryptionShare[C]) Index() int {
+	return s.index
+}
+
+func (enc *Cipher[C]) PartialDecrypt(ks KeyShare[C], ct Ciphertext[C]) (DecryptionShare[C], error) {
+	d := curve.MulSecret[C](ct.c1, ks.sk)
+	x := dleq.MakeWord[C](enc.gen.Generator(), enc.gen.MulBase(ks.sk), ct.c1, d)
+	proof, err := enc.shareNIZK().Prove(x, dleq.Witness[C](ks.sk))
+	if err != nil {
+		return DecryptionShare[C]{}, fmt.Errorf("proving partial decryption: %w", err)
+	}
+	return DecryptionShare[C]{
+		index: ks.index,
+		d:     d,
+		proof: proof,
+	}, nil
+}
+
+// Combine verifies the decryption shares and decrypts the ciphertext from the
+// first t valid shares of distinct parties. Invalid shares are ignored.
+func (enc *Cipher[C]) Combine(
+	tk ThresholdKey[C],
+	ct Ciphertext[C],
+	shares []DecryptionShare[C],
+) ([]byte, error) {
+	m, err := enc.combine(tk, ct, shares)
+	if err != nil {
+		return nil, err
+	}
+	return enc.gen.DecodeFromPoint(m), nil
+}
+
+// CombineInt is like Combine for ciphertexts created by EncryptInt. It
+// returns the plaintext in [min, max].
+func (enc *Cipher[C]) CombineInt(
+	tk ThresholdKey[C],
+	ct Ciphertext[C],
+	shares []DecryptionShare[C],
+	table *DLogTable[C],
+	min, max int64,
+) (int64, error) {
+	m, err := enc.combine(tk, ct, shares)
+	if err != nil {
+		return 0, err
+	}
+	return table.DLog(m, min, max)
+}
+
+// combine returns the plaintext point c2 - sk*c1.
+func (enc *Cipher[C]) combine(
+	tk ThresholdKey[C],
+	ct Ciphertext[C],
+	shares []DecryptionShare[C],
+) (curve.Point[C], error) {
+	if tk.t < 1 {
+		return nil, fmt.Errorf("invalid threshold: %d", tk.t)
+	}
+	var indices []int
+	var points []curve.Point[C]
+	seen := make(map[int]bool)
+	for _, s := range shares {
+		if len(indices) == tk.t {
+			break
+		}
+		if s.index < 1 || s.index > len(tk.vks) || seen[s.index] || s.d == nil {
+			continue
+		}
+		x := dleq.MakeWord[C](enc.gen.Generator(), tk.vks[s.index-1], ct.c1, s.d)
+		if !enc.shareNIZK().Verify(x, s.proof) {
+			continue
+		}
+		seen[s.index] = true
+		indices = append(indices, s.index)
+		points = append(points, s.d)
+	}
+	if len(indices) < tk.t {
+		return nil, fmt.Errorf("not enough valid shares: %d of %d", len(indices), tk.t)
+	}
+
+	s := curve.MultiScalarMul(points, shamir.LagrangeCoefficients(enc.gen, indices))
+	return ct.c2.Sub(s), nil
+}
+
+// verifyCommitments checks the proof of knowledge of dealer i.
+func (enc *Cipher[C]) verifyCommitments(i int, c Commitments[C]) error {
+	if len(c.points) == 0 {
+		return fmt.Errorf("dealer %d: no commitments", i)
+	}
+	if !enc.dealNIZK(i).Verify(dlog.Word[C](c.points[0]), c.proof) {
+		return fmt.Errorf("dealer %d: invalid proof of knowledge", i)
+	}
+	return nil
+}
+
+// dealNIZK returns the NIZK for the proof of knowledge of dealer i, whose
+// label includes the index so that a proof cannot be replayed by another
+// dealer.
+func (enc *Cipher[C]) dealNIZK(i int) nizk.NIZK[C, dlog.Protocol] {
+	label := binary.BigEndian.AppendUint32(append([]byte(nil), dealDST...), uint32(i))
+	return nizk.New[C, dlog.Protocol](
+		dlog.NewProver[C](enc.gen, enc.rnd),
+		dlog.NewVerifier[C](enc.gen, enc.rnd),
+		dlog.NewEncoder[C](enc.gen),
+		label,
+	)
+}
+
+func (enc *Cipher[C]) shareNIZK() nizk.NIZK[C, dleq.Protocol] {
+	return nizk.New[C, dleq.Protocol](
+		dleq.NewProver[C](enc.gen, enc.rnd),
+		dleq.NewVerifier[C](enc.gen, enc.rnd),
+		dleq.NewEncoder[C](enc.gen),
+		shareDST,
+	)
+}
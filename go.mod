@@ -5,4 +5,5 @@ go 1.19
 require (
     github.com/decred/dcrd/dcrec/secp256k1/v4 v4.1.0
     filippo.io/edwards25519 v1.0.0
+    filippo.io/nistec v0.0.3
 )
@@ -0,0 +1,54 @@
+// dem implements the data encapsulation of the hybrid encryption schemes. The
+// symmetric key is derived with SHA-256 from a domain separation tag and the
+// key encapsulation, and data is encrypted with AES-256-GCM.
+package dem
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"fmt"
+)
+
+type AEAD struct {
+	aead cipher.AEAD
+}
+
+// NewAEAD derives the symmetric key from the domain separation tag dst and the
+// concatenation of the key material parts.
+func NewAEAD(dst []byte, parts ...[]byte) (AEAD, error) {
+	h := sha256.New()
+	h.Write(dst)
+	for _, p := range parts {
+		h.Write(p)
+	}
+	key := h.Sum(nil)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return AEAD{}, fmt.Errorf("creating cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return AEAD{}, fmt.Errorf("creating AEAD: %w", err)
+	}
+	return AEAD{
+		aead: aead,
+	}, nil
+}
+
+// Seal encrypts and authenticates data, authenticates ad, and appends the
+// result to out. Every derived key must be used for a single message only, as
+// the nonce is fixed.
+func (a AEAD) Seal(out, data, ad []byte) []byte {
+	return a.aead.Seal(out, a.nonce(), data, ad)
+}
+
+// Open decrypts and authenticates sealed and authenticates ad.
+func (a AEAD) Open(sealed, ad []byte) ([]byte, error) {
+	return a.aead.Open(nil, a.nonce(), sealed, ad)
+}
+
+func (a AEAD) nonce() []byte {
+	return make([]byte, a.aead.NonceSize())
+}
@@ -0,0 +1,68 @@
+// shamir implements the polynomial arithmetic of Shamir secret sharing and
+// Feldman commitments. Polynomials are given by their coefficients, starting
+// with the constant term, and parties are identified by the evaluation points
+// 1, 2, ...
+package shamir
+
+import (
+	"io"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+)
+
+// RandomPolynomial returns a random polynomial of degree t-1.
+func RandomPolynomial[C curve.Curve](gen curve.Generator[C], rnd io.Reader, t int) ([]curve.Scalar[C], error) {
+	coeffs := make([]curve.Scalar[C], t)
+	for k := range coeffs {
+		var err error
+		coeffs[k], err = gen.RandomScalar(rnd)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return coeffs, nil
+}
+
+// Eval computes f(x) using Horner's method.
+func Eval[C curve.Curve](gen curve.Generator[C], coeffs []curve.Scalar[C], x int) curve.Scalar[C] {
+	xs := gen.NewScalar(big.NewInt(int64(x)))
+	s := gen.Zero()
+	for k := len(coeffs) - 1; k >= 0; k-- {
+		s = s.Mul(xs).Add(coeffs[k])
+	}
+	return s
+}
+
+// EvalCommitments computes f(x)*G from the commitments to the coefficients of
+// f.
+func EvalCommitments[C curve.Curve](gen curve.Generator[C], commitments []curve.Point[C], x int) curve.Point[C] {
+	xs := gen.NewScalar(big.NewInt(int64(x)))
+	powers := make([]curve.Scalar[C], len(commitments))
+	p := gen.One()
+	for k := range powers {
+		powers[k] = p
+		p = p.Mul(xs)
+	}
+	return curve.MultiScalarMul(commitments, powers)
+}
+
+// LagrangeCoefficients returns the coefficients for interpolating the value
+// at 0 of a polynomial from its values at the given distinct indices.
+func LagrangeCoefficients[C curve.Curve](gen curve.Generator[C], indices []int) []curve.Scalar[C] {
+	coeffs := make([]curve.Scalar[C], len(indices))
+	for k, i := range indices {
+		num, den := gen.One(), gen.One()
+		xi := gen.NewScalar(big.NewInt(int64(i)))
+		for _, j := range indices {
+			if j == i {
+				continue
+			}
+			xj := gen.NewScalar(big.NewInt(int64(j)))
+			num = num.Mul(xj)
+			den = den.Mul(xj.Sub(xi))
+		}
+		coeffs[k] = num.Mul(den.Inv())
+	}
+	return coeffs
+}
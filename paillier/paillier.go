@@ -0,0 +1,229 @@
+// paillier implements the Paillier cryptosystem with generator g = n+1.
+// Ciphertexts are additively homomorphic in the plaintext. Arithmetic is based
+// on math/big and is not constant-time.
+package paillier
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+var one = big.NewInt(1)
+
+type PublicKey struct {
+	n, n2 *big.Int
+}
+
+type SecretKey struct {
+	PublicKey
+	lambda, mu *big.Int
+}
+
+type Ciphertext struct {
+	c *big.Int
+}
+
+// GenerateKey generates a key pair with a modulus of the given bit length.
+func GenerateKey(rnd io.Reader, bits int) (*SecretKey, error) {
+	if bits < 16 {
+		return nil, fmt.Errorf("modulus too small: %d bits", bits)
+	}
+	for {
+		p, err := rand.Prime(rnd, bits/2)
+		if err != nil {
+			return nil, fmt.Errorf("generating prime: %w", err)
+		}
+		q, err := rand.Prime(rnd, bits-bits/2)
+		if err != nil {
+			return nil, fmt.Errorf("generating prime: %w", err)
+		}
+		if p.Cmp(q) == 0 {
+			continue
+		}
+		n := new(big.Int).Mul(p, q)
+		if n.BitLen() != bits {
+			continue
+		}
+		pm1 := new(big.Int).Sub(p, one)
+		qm1 := new(big.Int).Sub(q, one)
+		lambda := new(big.Int).Mul(pm1, qm1)
+		mu := new(big.Int).ModInverse(lambda, n)
+		if mu == nil {
+			// gcd(n, phi(n)) != 1, which cannot happen for primes of equal
+			// length but is checked for completeness.
+			continue
+		}
+		return &SecretKey{
+			PublicKey: makePublicKey(n),
+			lambda:    lambda,
+			mu:        mu,
+		}, nil
+	}
+}
+
+// NewPublicKey returns the public key with modulus n.
+func NewPublicKey(n *big.Int) (*PublicKey, error) {
+	if n.Sign() <= 0 || n.Bit(0) == 0 || n.BitLen() < 16 {
+		return nil, fmt.Errorf("invalid modulus")
+	}
+	pk := makePublicKey(new(big.Int).Set(n))
+	return &pk, nil
+}
+
+func makePublicKey(n *big.Int) PublicKey {
+	return PublicKey{
+		n:  n,
+		n2: new(big.Int).Mul(n, n),
+	}
+}
+
+func (sk *SecretKey) Public() *PublicKey {
+	return &sk.PublicKey
+}
+
+// N returns the modulus.
+func (pk *PublicKey) N() *big.Int {
+	return new(big.Int).Set(pk.n)
+}
+
+// RandomNonce samples an encryption nonce uniformly from Z*_n. The nonce is
+// derived deterministically from the bytes read from `rnd`.
+func (pk *PublicKey) RandomNonce(rnd io.Reader) (*big.Int, error) {
+	buf := make([]byte, (pk.n.BitLen()+7)/8)
+	excess := uint(len(buf)*8 - pk.n.BitLen())
+	r := new(big.Int)
+	for {
+		if _, err := io.ReadFull(rnd, buf); err != nil {
+			return nil, fmt.Errorf("reading randomness: %w", err)
+		}
+		buf[0] &= 0xff >> excess
+		r.SetBytes(buf)
+		if pk.isUnit(r) {
+			return r, nil
+		}
+	}
+}
+
+// Encrypt encrypts `m` using a fresh nonce drawn from `rnd`. It returns the
+// ciphertext and the nonce.
+func (pk *PublicKey) Encrypt(rnd io.Reader, m *big.Int) (*Ciphertext, *big.Int, error) {
+	r, err := pk.RandomNonce(rnd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sampling nonce: %w", err)
+	}
+	ct, err := pk.EncryptWithNonce(m, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ct, r, nil
+}
+
+// EncryptWithNonce computes (1 + m*n) * r^n mod n^2, where `m` must be in
+// [0, n) and `r` in Z*_n.
+func (pk *PublicKey) EncryptWithNonce(m, r *big.Int) (*Ciphertext, error) {
+	if m.Sign() < 0 || m.Cmp(pk.n) >= 0 {
+		return nil, fmt.Errorf("message out of range")
+	}
+	if !pk.isUnit(r) {
+		return nil, fmt.Errorf("nonce not in Z*_n")
+	}
+	c := new(big.Int).Exp(r, pk.n, pk.n2)
+	c.Mul(c, pk.gPow(m))
+	c.Mod(c, pk.n2)
+	return &Ciphertext{c: c}, nil
+}
+
+// Decrypt decrypts `ct` and returns the plaintext in [0, n).
+func (sk *SecretKey) Decrypt(ct *Ciphertext) (*big.Int, error) {
+	if !sk.isCiphertext(ct.c) {
+		return nil, fmt.Errorf("invalid ciphertext")
+	}
+	// m = L(c^lambda mod n^2) * mu mod n, with L(u) = (u-1)/n.
+	u := new(big.Int).Exp(ct.c, sk.lambda, sk.n2)
+	u.Sub(u, one)
+	u.Div(u, sk.n)
+	u.Mul(u, sk.mu)
+	return u.Mod(u, sk.n), nil
+}
+
+// Add returns an encryption of the sum of the plaintexts of `a` and `b`.
+func (pk *PublicKey) Add(a, b *Ciphertext) *Ciphertext {
+	c := new(big.Int).Mul(a.c, b.c)
+	return &Ciphertext{c: c.Mod(c, pk.n2)}
+}
+
+// AddPlain returns an encryption of the plaintext of `a` plus `m` mod n.
+func (pk *PublicKey) AddPlain(a *Ciphertext, m *big.Int) *Ciphertext {
+	mm := new(big.Int).Mod(m, pk.n)
+	c := new(big.Int).Mul(a.c, pk.gPow(mm))
+	return &Ciphertext{c: c.Mod(c, pk.n2)}
+}
+
+// MulPlain returns an encryption of the plaintext of `a` times `k` mod n.
+func (pk *PublicKey) MulPlain(a *Ciphertext, k *big.Int) *Ciphertext {
+	kk := new(big.Int).Mod(k, pk.n)
+	return &Ciphertext{c: new(big.Int).Exp(a.c, kk, pk.n2)}
+}
+
+// Rerandomize returns a fresh encryption of the plaintext of `a` using nonce
+// `r`.
+func (pk *PublicKey) Rerandomize(a *Ciphertext, r *big.Int) (*Ciphertext, error) {
+	if !pk.isUnit(r) {
+		return nil, fmt.Errorf("nonce not in Z*_n")
+	}
+	c := new(big.Int).Exp(r, pk.n, pk.n2)
+	c.Mul(c, a.c)
+	return &Ciphertext{c: c.Mod(c, pk.n2)}, nil
+}
+
+// CiphertextSize returns the length of encoded ciphertexts in bytes.
+func (pk *PublicKey) CiphertextSize() int {
+	return (pk.n2.BitLen() + 7) / 8
+}
+
+// EncodeCiphertext encodes `ct` as a fixed-length big-endian integer.
+func (pk *PublicKey) EncodeCiphertext(ct *Ciphertext) []byte {
+	return ct.c.FillBytes(make([]byte, pk.CiphertextSize()))
+}
+
+// DecodeCiphertext decodes a ciphertext and checks that it is in Z*_{n^2}.
+func (pk *PublicKey) DecodeCiphertext(b []byte) (*Ciphertext, error) {
+	if len(b) != pk.CiphertextSize() {
+		return nil, fmt.Errorf("invalid ciphertext length: %d", len(b))
+	}
+	c := new(big.Int).SetBytes(b)
+	if !pk.isCiphertext(c) {
+		return nil, fmt.Errorf("invalid ciphertext")
+	}
+	return &Ciphertext{c: c}, nil
+}
+
+// Int returns the ciphertext as an integer modulo n^2.
+func (ct *Ciphertext) Int() *big.Int {
+	return new(big.Int).Set(ct.c)
+}
+
+// gPow computes (n+1)^m = 1 + m*n mod n^2.
+func (pk *PublicKey) gPow(m *big.Int) *big.Int {
+	g := new(big.Int).Mul(m, pk.n)
+	g.Add(g, one)
+	return g.Mod(g, pk.n2)
+}
+
+// isUnit reports whether 0 < r < n and gcd(r, n) = 1.
+func (pk *PublicKey) isUnit(r *big.Int) bool {
+	if r.Sign() <= 0 || r.Cmp(pk.n) >= 0 {
+		return false
+	}
+	return new(big.Int).GCD(nil, nil, r, pk.n).Cmp(one) == 0
+}
+
+// isCiphertext reports whether 0 < c < n^2 and gcd(c, n) = 1.
+func (pk *PublicKey) isCiphertext(c *big.Int) bool {
+	if c.Sign() <= 0 || c.Cmp(pk.n2) >= 0 {
+		return false
+	}
+	return new(big.Int).GCD(nil, nil, c, pk.n).Cmp(one) == 0
+}
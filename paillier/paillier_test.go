@@ -0,0 +1,136 @@
+package paillier_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/matthiasgeihs/go-curve/paillier"
+)
+
+func TestPaillier(t *testing.T) {
+	rnd := rand.Reader
+	sk, err := paillier.GenerateKey(rnd, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := sk.Public()
+	if pk.N().BitLen() != 1024 {
+		t.Errorf("modulus should have 1024 bits, has %d", pk.N().BitLen())
+	}
+
+	randomMessage := func() *big.Int {
+		m, err := rand.Int(rnd, pk.N())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+	encrypt := func(m *big.Int) *paillier.Ciphertext {
+		ct, _, err := pk.Encrypt(rnd, m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ct
+	}
+	decryptsTo := func(ct *paillier.Ciphertext, m *big.Int) bool {
+		mDec, err := sk.Decrypt(ct)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return mDec.Cmp(new(big.Int).Mod(m, pk.N())) == 0
+	}
+
+	m1, m2 := randomMessage(), randomMessage()
+	ct1, r1, err := pk.Encrypt(rnd, m1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct2 := encrypt(m2)
+	if !decryptsTo(ct1, m1) {
+		t.Error("decryption should equal message")
+	}
+
+	// Encryption with explicit randomness is deterministic.
+	ctReplay, err := pk.EncryptWithNonce(m1, r1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ctReplay.Int().Cmp(ct1.Int()) != 0 {
+		t.Error("encryption with same nonce should equal ciphertext")
+	}
+
+	sum := new(big.Int).Add(m1, m2)
+	if !decryptsTo(pk.Add(ct1, ct2), sum) {
+		t.Error("Add should add plaintexts")
+	}
+	if !decryptsTo(pk.AddPlain(ct1, m2), sum) {
+		t.Error("AddPlain should add plaintexts")
+	}
+	if !decryptsTo(pk.MulPlain(ct1, m2), new(big.Int).Mul(m1, m2)) {
+		t.Error("MulPlain should multiply plaintexts")
+	}
+	if !decryptsTo(pk.MulPlain(ct1, big.NewInt(-1)), new(big.Int).Neg(m1)) {
+		t.Error("MulPlain by -1 should negate plaintext")
+	}
+	r, err := pk.RandomNonce(rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctRand, err := pk.Rerandomize(ct1, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ctRand.Int().Cmp(ct1.Int()) == 0 || !decryptsTo(ctRand, m1) {
+		t.Error("Rerandomize should change ciphertext but not plaintext")
+	}
+
+	// Encoding.
+	b := pk.EncodeCiphertext(ct1)
+	if len(b) != pk.CiphertextSize() {
+		t.Errorf("encoding should have %d bytes, has %d", pk.CiphertextSize(), len(b))
+	}
+	ctDec, err := pk.DecodeCiphertext(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ctDec.Int().Cmp(ct1.Int()) != 0 {
+		t.Error("decoded ciphertext should equal ciphertext")
+	}
+	if _, err := pk.DecodeCiphertext(b[1:]); err == nil {
+		t.Error("truncated ciphertext should not decode")
+	}
+	if _, err := pk.DecodeCiphertext(make([]byte, len(b))); err == nil {
+		t.Error("zero ciphertext should not decode")
+	}
+	nMult := new(big.Int).Lsh(pk.N(), 5).FillBytes(make([]byte, len(b)))
+	if _, err := pk.DecodeCiphertext(nMult); err == nil {
+		t.Error("ciphertext not coprime to modulus should not decode")
+	}
+	if _, err := pk.DecodeCiphertext(bytes.Repeat([]byte{0xff}, len(b))); err == nil {
+		t.Error("ciphertext out of range should not decode")
+	}
+
+	// Invalid inputs.
+	if _, err := pk.EncryptWithNonce(pk.N(), r1); err == nil {
+		t.Error("message out of range should be rejected")
+	}
+	if _, err := pk.EncryptWithNonce(m1, big.NewInt(0)); err == nil {
+		t.Error("zero nonce should be rejected")
+	}
+	pkN, err := paillier.NewPublicKey(pk.N())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctN, err := pkN.EncryptWithNonce(m1, r1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ctN.Int().Cmp(ct1.Int()) != 0 {
+		t.Error("public key from modulus should encrypt identically")
+	}
+	if _, err := paillier.NewPublicKey(big.NewInt(1 << 20)); err == nil {
+		t.Error("even modulus should be rejected")
+	}
+}
@@ -1,6 +1,8 @@
 package binary
 
 import (
+	"io"
+
 	"github.com/matthiasgeihs/go-curve/curve"
 	"github.com/matthiasgeihs/go-curve/sigma"
 )
@@ -20,6 +22,12 @@ type Prover[C curve.Curve, P Protocol] interface {
 	) Response[C, P]
 }
 
+// RandProver is implemented by provers that can be instantiated with a
+// different randomness source for their commitments.
+type RandProver[C curve.Curve, P Protocol] interface {
+	WithRand(io.Reader) Prover[C, P]
+}
+
 type Verifier[C curve.Curve, P Protocol] interface {
 	Challenge(Commitment[C, P]) (Challenge, error)
 	Verify(Word[C, P], Commitment[C, P], Challenge, Response[C, P]) bool
@@ -30,6 +38,7 @@ type Extractor[C curve.Curve, P Protocol] interface {
 }
 
 type Encoder[C curve.Curve, P Protocol] interface {
+	EncodeWord(Word[C, P]) ([]byte, error)
 	EncodeCommitment(Commitment[C, P]) ([]byte, error)
 	DecodeCommitment([]byte) (Commitment[C, P], error)
 	EncodeResponse(Response[C, P]) []byte
@@ -0,0 +1,5 @@
+// dleq implements the Sigma protocol for proving the equality of discrete
+// logarithms from Chaum and Pedersen, "Wallet Databases with Observers",
+// CRYPTO 1992. For a word (G, X, H, Y), the prover shows knowledge of w with
+// X = w*G and Y = w*H.
+package dleq
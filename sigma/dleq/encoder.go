@@ -0,0 +1,95 @@
+package dleq
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/sigma"
+)
+
+type Encoder[C curve.Curve] struct {
+	gen curve.Generator[C]
+}
+
+func NewEncoder[C curve.Curve](gen curve.Generator[C]) Encoder[C] {
+	return Encoder[C]{
+		gen: gen,
+	}
+}
+
+// EncodeWord encodes the points of the word, each prefixed with its length.
+func (e Encoder[C]) EncodeWord(x sigma.Word[C, Protocol]) ([]byte, error) {
+	dleqX := x.(Word[C])
+	return encodePoints(dleqX.g, dleqX.x, dleqX.h, dleqX.y)
+}
+
+func (e Encoder[C]) EncodeCommitment(comm sigma.Commitment[C, Protocol]) ([]byte, error) {
+	t := comm.(Commitment[C])
+	return encodePoints(t.t1, t.t2)
+}
+
+func (e Encoder[C]) DecodeCommitment(data []byte) (sigma.Commitment[C, Protocol], error) {
+	ps, err := decodePoints(e.gen, data, 2)
+	if err != nil {
+		return nil, err
+	}
+	return Commitment[C]{t1: ps[0], t2: ps[1]}, nil
+}
+
+// HashToChallenge hashes the transcript to a scalar challenge.
+func (e Encoder[C]) HashToChallenge(dst, transcript []byte) sigma.Challenge[C, Protocol] {
+	return Challenge[C](e.gen.HashToScalar(dst, transcript))
+}
+
+func (e Encoder[C]) EncodeResponse(resp sigma.Response[C, Protocol]) []byte {
+	dleqResp := resp.(Response[C])
+	return dleqResp.Int().Bytes()
+}
+
+func (e Encoder[C]) DecodeResponse(data []byte) sigma.Response[C, Protocol] {
+	bi := new(big.Int).SetBytes(data)
+	return Response[C](e.gen.NewScalar(bi.Mod(bi, e.gen.GeneratorOrder())))
+}
+
+func (e Encoder[C]) EncodeWitness(w sigma.Witness[C, Protocol]) []byte {
+	dleqW := w.(Witness[C])
+	return dleqW.Int().Bytes()
+}
+
+func (e Encoder[C]) DecodeWitness(data []byte) sigma.Witness[C, Protocol] {
+	bi := new(big.Int).SetBytes(data)
+	return Witness[C](e.gen.NewScalar(bi.Mod(bi, e.gen.GeneratorOrder())))
+}
+
+func encodePoints[C curve.Curve](ps ...curve.Point[C]) ([]byte, error) {
+	var data []byte
+	for _, p := range ps {
+		b := p.CompressedBytes()
+		if len(b) > 255 {
+			return nil, fmt.Errorf("point encoding too long: %d", len(b))
+		}
+		data = append(data, byte(len(b)))
+		data = append(data, b...)
+	}
+	return data, nil
+}
+
+func decodePoints[C curve.Curve](gen curve.Generator[C], data []byte, n int) ([]curve.Point[C], error) {
+	ps := make([]curve.Point[C], n)
+	for i := range ps {
+		if len(data) == 0 || len(data) < 1+int(data[0]) {
+			return nil, fmt.Errorf("invalid length")
+		}
+		p, err := gen.DecodePoint(data[1 : 1+data[0]])
+		if err != nil {
+			return nil, fmt.Errorf("decoding point: %w", err)
+		}
+		ps[i] = p
+		data = data[1+data[0]:]
+	}
+	if len(data) != 0 {
+		return nil, fmt.Errorf("trailing data")
+	}
+	return ps, nil
+}
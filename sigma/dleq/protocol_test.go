@@ -0,0 +1,107 @@
+package dleq_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve/ristretto255"
+	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
+	"github.com/matthiasgeihs/go-curve/sigma"
+	"github.com/matthiasgeihs/go-curve/sigma/dleq"
+	"github.com/matthiasgeihs/go-curve/sigma/nizk"
+)
+
+var _ sigma.Prover[secp256k1.Curve, dleq.Protocol] = dleq.Prover[secp256k1.Curve]{}
+var _ sigma.Verifier[secp256k1.Curve, dleq.Protocol] = dleq.Verifier[secp256k1.Curve]{}
+var _ sigma.Extractor[secp256k1.Curve, dleq.Protocol] = dleq.Extractor[secp256k1.Curve]{}
+var _ nizk.Encoder[secp256k1.Curve, dleq.Protocol] = dleq.Encoder[secp256k1.Curve]{}
+
+func TestProtocol_secp256k1(t *testing.T) {
+	testProtocol[secp256k1.Curve](t, secp256k1.NewGenerator())
+}
+
+func TestProtocol_edwards25519(t *testing.T) {
+	testProtocol[edwards25519.Curve](t, edwards25519.NewGenerator())
+}
+
+func TestProtocol_ristretto255(t *testing.T) {
+	testProtocol[ristretto255.Curve](t, ristretto255.NewGenerator())
+}
+
+func testProtocol[C curve.Curve](t *testing.T, g curve.Generator[C]) {
+	rnd := rand.Reader
+	p := dleq.NewProver(g, rnd)
+	v := dleq.NewVerifier(g, rnd)
+	e := dleq.NewExtractor(g)
+	enc := dleq.NewEncoder(g)
+
+	random := func() curve.Scalar[C] {
+		s, err := g.RandomScalar(rnd)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	w := random()
+	h := g.MulBase(random())
+	x := dleq.MakeWord(g.Generator(), g.MulBase(w), h, h.Mul(w))
+
+	run := func(x dleq.Word[C], w curve.Scalar[C]) bool {
+		com, decom, err := p.Commit(x, w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ch, err := v.Challenge(com)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v.Verify(x, com, ch, p.Respond(x, w, decom, ch))
+	}
+
+	t.Run("honest", func(t *testing.T) {
+		if !run(x, w) {
+			t.Error("proof should be valid")
+		}
+	})
+
+	t.Run("malicious", func(t *testing.T) {
+		if run(x, random()) {
+			t.Error("proof with wrong witness should be invalid")
+		}
+		xUnequal := dleq.MakeWord(g.Generator(), g.MulBase(w), h, h.Mul(random()))
+		if run(xUnequal, w) {
+			t.Error("proof for unequal logarithms should be invalid")
+		}
+	})
+
+	t.Run("extract", func(t *testing.T) {
+		_, decom, err := p.Commit(x, w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ch1, ch2 := dleq.Challenge[C](random()), dleq.Challenge[C](random())
+		t1 := sigma.MakeTranscript[C, dleq.Protocol](ch1, p.Respond(x, w, decom, ch1))
+		t2 := sigma.MakeTranscript[C, dleq.Protocol](ch2, p.Respond(x, w, decom, ch2))
+		wExt := e.Extract(t1, t2).(dleq.Witness[C])
+		if !wExt.Equal(w) {
+			t.Error("extracted witness should equal witness")
+		}
+	})
+
+	t.Run("nizk", func(t *testing.T) {
+		n := nizk.New[C, dleq.Protocol](p, v, enc, []byte("label"))
+		proof, err := n.Prove(x, w)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !n.Verify(x, proof) {
+			t.Error("proof should verify")
+		}
+		xOther := dleq.MakeWord(g.Generator(), g.MulBase(w), h, h.Mul(w).Add(h))
+		if n.Verify(xOther, proof) {
+			t.Error("proof should not verify for a different word")
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package dleq
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/sigma"
+)
+
+type Verifier[C curve.Curve] struct {
+	gen curve.Generator[C]
+	rnd io.Reader
+}
+
+// Word states that log_g(x) = log_h(y).
+type Word[C curve.Curve] struct {
+	g, x, h, y curve.Point[C]
+}
+
+func MakeWord[C curve.Curve](g, x, h, y curve.Point[C]) Word[C] {
+	return Word[C]{
+		g: g,
+		x: x,
+		h: h,
+		y: y,
+	}
+}
+
+func NewVerifier[C curve.Curve](
+	gen curve.Generator[C],
+	rnd io.Reader,
+) Verifier[C] {
+	return Verifier[C]{
+		gen: gen,
+		rnd: rnd,
+	}
+}
+
+func (v Verifier[C]) Challenge(sigma.Commitment[C, Protocol]) (sigma.Challenge[C, Protocol], error) {
+	c, err := v.gen.RandomScalar(v.rnd)
+	if err != nil {
+		return nil, fmt.Errorf("sampling scalar: %w", err)
+	}
+	return Challenge[C](c), nil
+}
+
+func (v Verifier[C]) Verify(
+	x sigma.Word[C, Protocol],
+	com sigma.Commitment[C, Protocol],
+	ch sigma.Challenge[C, Protocol],
+	resp sigma.Response[C, Protocol],
+) bool {
+	t := com.(Commitment[C])
+	c := ch.(Challenge[C])
+	s := resp.(Response[C])
+	dleqX := x.(Word[C])
+	// Check g^s == t1 * x^c and h^s == t2 * y^c.
+	gsxc := curve.MultiScalarMul(
+		[]curve.Point[C]{dleqX.g, dleqX.x},
+		[]curve.Scalar[C]{s, c.Neg()},
+	)
+	hsyc := curve.MultiScalarMul(
+		[]curve.Point[C]{dleqX.h, dleqX.y},
+		[]curve.Scalar[C]{s, c.Neg()},
+	)
+	return gsxc.Equal(t.t1) && hsyc.Equal(t.t2)
+}
@@ -1,8 +1,6 @@
 package binary
 
 import (
-	"bytes"
-	"encoding/binary"
 	"fmt"
 	"math/big"
 
@@ -21,68 +19,22 @@ func NewEncoder[C curve.Curve](gen curve.Generator[C]) Encoder[C] {
 	}
 }
 
+func (e Encoder[C]) EncodeWord(x sigma.Word[C, Protocol]) ([]byte, error) {
+	dlogX := x.(dlog.Word[C])
+	return dlogX.CompressedBytes(), nil
+}
+
 func (e Encoder[C]) EncodeCommitment(comm sigma.Commitment[C, Protocol]) ([]byte, error) {
 	dlogComm := comm.(dlog.Commitment[C])
-	var buf bytes.Buffer
-
-	// Encode X.
-	err := writeBigInt(&buf, dlogComm.X())
-	if err != nil {
-		return nil, fmt.Errorf("encoding X: %w", err)
-	}
-
-	// Encode Y.
-	err = writeBigInt(&buf, dlogComm.Y())
-	if err != nil {
-		return nil, fmt.Errorf("encoding Y: %w", err)
-	}
-
-	return buf.Bytes(), nil
+	return dlogComm.CompressedBytes(), nil
 }
 
 func (e Encoder[C]) DecodeCommitment(data []byte) (sigma.Commitment[C, Protocol], error) {
-	buf := bytes.NewBuffer(data)
-
-	// Decode X.
-	x, err := readBigInt(buf)
-	if err != nil {
-		return nil, fmt.Errorf("decoding X: %w", err)
-	}
-
-	// Decode Y.
-	y, err := readBigInt(buf)
-	if err != nil {
-		return nil, fmt.Errorf("decoding Y: %w", err)
-	}
-
-	return e.gen.NewPoint(x, y), nil
-}
-
-func writeBigInt(buf *bytes.Buffer, i *big.Int) error {
-	b, err := i.GobEncode()
-	if err != nil {
-		return fmt.Errorf("encoding big int to bytes: %w", err)
-	}
-	err = binary.Write(buf, binary.BigEndian, b)
-	if err != nil {
-		return fmt.Errorf("encoding byte slice: %w", err)
-	}
-	return nil
-}
-
-func readBigInt(buf *bytes.Buffer) (*big.Int, error) {
-	var b []byte
-	err := binary.Read(buf, binary.BigEndian, &b)
-	if err != nil {
-		return nil, fmt.Errorf("decoding byte slice: %w", err)
-	}
-
-	i := new(big.Int)
-	err = i.GobDecode(b)
+	p, err := e.gen.DecodePoint(data)
 	if err != nil {
-		return nil, fmt.Errorf("decoding big int from bytes: %w", err)
+		return nil, fmt.Errorf("decoding point: %w", err)
 	}
-	return i, nil
+	return dlog.Commitment[C](p), nil
 }
 
 func (e Encoder[C]) EncodeResponse(resp sigma.Response[C, Protocol]) []byte {
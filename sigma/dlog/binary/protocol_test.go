@@ -7,6 +7,8 @@ import (
 
 	"github.com/matthiasgeihs/go-curve/curve"
 	"github.com/matthiasgeihs/go-curve/curve/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve/p256"
+	"github.com/matthiasgeihs/go-curve/curve/ristretto255"
 	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
 	sigma "github.com/matthiasgeihs/go-curve/sigma/binary"
 	"github.com/matthiasgeihs/go-curve/sigma/dlog"
@@ -14,6 +16,7 @@ import (
 )
 
 var _ sigma.Prover[secp256k1.Curve, binary.Protocol] = binary.Prover[secp256k1.Curve]{}
+var _ sigma.RandProver[secp256k1.Curve, binary.Protocol] = binary.Prover[secp256k1.Curve]{}
 var _ sigma.Verifier[secp256k1.Curve, binary.Protocol] = binary.Verifier[secp256k1.Curve]{}
 var _ sigma.Extractor[secp256k1.Curve, binary.Protocol] = binary.Extractor[secp256k1.Curve]{}
 
@@ -39,6 +42,26 @@ func TestProtocol_edwards25519(t *testing.T) {
 	testProtocol[C, binary.Protocol](t, rnd, g, p, v, e)
 }
 
+func TestProtocol_ristretto255(t *testing.T) {
+	rnd := rand.Reader
+	type C = ristretto255.Curve
+	g := ristretto255.NewGenerator()
+	p := binary.NewProver[C](g, rnd)
+	v := binary.NewVerifier[C](g, rnd)
+	e := binary.NewExtractor[C](g)
+	testProtocol[C, binary.Protocol](t, rnd, g, p, v, e)
+}
+
+func TestProtocol_p256(t *testing.T) {
+	rnd := rand.Reader
+	type C = p256.Curve
+	g := p256.NewGenerator()
+	p := binary.NewProver[C](g, rnd)
+	v := binary.NewVerifier[C](g, rnd)
+	e := binary.NewExtractor[C](g)
+	testProtocol[C, binary.Protocol](t, rnd, g, p, v, e)
+}
+
 func testProtocol[C curve.Curve, P sigma.Protocol](
 	t *testing.T,
 	rnd io.Reader,
@@ -82,7 +105,7 @@ func testProtocol[C curve.Curve, P sigma.Protocol](
 	t.Run("extract", func(t *testing.T) {
 		check := func(w dlog.Witness[C]) bool {
 			gw := g.Generator().Mul(w)
-			return x.X().Cmp(gw.X()) == 0
+			return x.Equal(gw)
 		}
 		extract[C, P](t, p, v, e, check, x, w)
 	})
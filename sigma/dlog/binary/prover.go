@@ -27,6 +27,12 @@ func NewProver[C curve.Curve](
 	}
 }
 
+// WithRand returns a prover that reads the randomness of its commitments from
+// rnd.
+func (p Prover[C]) WithRand(rnd io.Reader) sigma.Prover[C, Protocol] {
+	return NewProver(p.gen, rnd)
+}
+
 func (p Prover[C]) Commit(
 	x sigma.Word[C, Protocol],
 	w sigma.Witness[C, Protocol],
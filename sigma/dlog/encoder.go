@@ -1,6 +1,7 @@
 package dlog
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/matthiasgeihs/go-curve/curve"
@@ -17,6 +18,29 @@ func NewEncoder[C curve.Curve](gen curve.Generator[C]) Encoder[C] {
 	}
 }
 
+func (e Encoder[C]) EncodeWord(x sigma.Word[C, Protocol]) ([]byte, error) {
+	dlogX := x.(Word[C])
+	return dlogX.CompressedBytes(), nil
+}
+
+func (e Encoder[C]) EncodeCommitment(comm sigma.Commitment[C, Protocol]) ([]byte, error) {
+	dlogComm := comm.(Commitment[C])
+	return dlogComm.CompressedBytes(), nil
+}
+
+func (e Encoder[C]) DecodeCommitment(data []byte) (sigma.Commitment[C, Protocol], error) {
+	p, err := e.gen.DecodePoint(data)
+	if err != nil {
+		return nil, fmt.Errorf("decoding point: %w", err)
+	}
+	return Commitment[C](p), nil
+}
+
+// HashToChallenge hashes the transcript to a scalar challenge.
+func (e Encoder[C]) HashToChallenge(dst, transcript []byte) sigma.Challenge[C, Protocol] {
+	return Challenge[C](e.gen.HashToScalar(dst, transcript))
+}
+
 func (e Encoder[C]) EncodeResponse(resp sigma.Response[C, Protocol]) []byte {
 	dlogResp := resp.(Response[C])
 	return dlogResp.Int().Bytes()
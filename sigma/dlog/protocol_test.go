@@ -7,6 +7,8 @@ import (
 
 	"github.com/matthiasgeihs/go-curve/curve"
 	"github.com/matthiasgeihs/go-curve/curve/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve/p256"
+	"github.com/matthiasgeihs/go-curve/curve/ristretto255"
 	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
 	"github.com/matthiasgeihs/go-curve/sigma"
 	"github.com/matthiasgeihs/go-curve/sigma/dlog"
@@ -36,6 +38,26 @@ func TestProtocol_edwards25519(t *testing.T) {
 	testProtocol[C, dlog.Protocol](t, rnd, g, p, v, e)
 }
 
+func TestProtocol_ristretto255(t *testing.T) {
+	rnd := rand.Reader
+	type C = ristretto255.Curve
+	g := ristretto255.NewGenerator()
+	p := dlog.NewProver[C](g, rnd)
+	v := dlog.NewVerifier[C](g, rnd)
+	e := dlog.NewExtractor[C](g)
+	testProtocol[C, dlog.Protocol](t, rnd, g, p, v, e)
+}
+
+func TestProtocol_p256(t *testing.T) {
+	rnd := rand.Reader
+	type C = p256.Curve
+	g := p256.NewGenerator()
+	p := dlog.NewProver[C](g, rnd)
+	v := dlog.NewVerifier[C](g, rnd)
+	e := dlog.NewExtractor[C](g)
+	testProtocol[C, dlog.Protocol](t, rnd, g, p, v, e)
+}
+
 func testProtocol[C curve.Curve, P sigma.Protocol](
 	t *testing.T,
 	rnd io.Reader,
@@ -72,7 +94,7 @@ func testProtocol[C curve.Curve, P sigma.Protocol](
 	t.Run("extract", func(t *testing.T) {
 		check := func(w dlog.Witness[C]) bool {
 			gw := g.Generator().Mul(w)
-			return x.X().Cmp(gw.X()) == 0
+			return x.Equal(gw)
 		}
 		extract[C, P](t, p, v, e, check, x, w)
 	})
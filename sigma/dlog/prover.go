@@ -44,7 +44,7 @@ func (p Prover[C]) Commit(
 		return nil, nil, fmt.Errorf("sampling scalar: %w", err)
 	}
 
-	t := p.gen.Generator().Mul(r)
+	t := p.gen.MulBase(r)
 	return Commitment[C](t), Decommitment[C](r), nil
 }
 
@@ -42,9 +42,11 @@ func (v Verifier[C]) Verify(
 	t := com.(Commitment[C])
 	c := ch.(Challenge[C])
 	s := resp.(Response[C])
-	gs := v.gen.Generator().Mul(s)
 	dlogX := x.(Word[C])
-	tyc := t.Add(curve.Point[C](dlogX).Mul(c))
-	valid := gs.X().Cmp(tyc.X()) == 0
-	return valid
+	// Check g^s == t * x^c as g^s * x^-c == t.
+	gsxc := curve.MultiScalarMul(
+		[]curve.Point[C]{v.gen.Generator(), dlogX},
+		[]curve.Scalar[C]{s, c.Neg()},
+	)
+	return gsxc.Equal(t)
 }
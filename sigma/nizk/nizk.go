@@ -0,0 +1,152 @@
+// nizk implements the Fiat-Shamir transform from Fiat and Shamir, "How To Prove
+// Yourself: Practical Solutions to Identification and Signature Problems",
+// CRYPTO 1986, which turns a Sigma protocol into a non-interactive
+// zero-knowledge proof in the random oracle model.
+package nizk
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/sigma"
+)
+
+// challengeDST is the domain separation tag used for deriving challenges.
+var challengeDST = []byte("go-curve-sigma-nizk-v1")
+
+type Encoder[C curve.Curve, P sigma.Protocol] interface {
+	EncodeWord(sigma.Word[C, P]) ([]byte, error)
+	EncodeCommitment(sigma.Commitment[C, P]) ([]byte, error)
+	DecodeCommitment([]byte) (sigma.Commitment[C, P], error)
+	EncodeResponse(sigma.Response[C, P]) []byte
+	DecodeResponse([]byte) sigma.Response[C, P]
+	// HashToChallenge deterministically derives a challenge from a transcript
+	// using the given domain separation tag.
+	HashToChallenge(dst, transcript []byte) sigma.Challenge[C, P]
+}
+
+// Proof is a serialized non-interactive proof. It consists of the encoded
+// commitment and the encoded response.
+type Proof []byte
+
+type NIZK[C curve.Curve, P sigma.Protocol] struct {
+	prover   sigma.Prover[C, P]
+	verifier sigma.Verifier[C, P]
+	encoder  Encoder[C, P]
+	label    []byte
+}
+
+// New returns a NIZK for the given Sigma protocol. The label binds proofs to
+// an application context; proofs only verify under the label they were
+// created with.
+func New[C curve.Curve, P sigma.Protocol](
+	prover sigma.Prover[C, P],
+	verifier sigma.Verifier[C, P],
+	encoder Encoder[C, P],
+	label []byte,
+) NIZK[C, P] {
+	return NIZK[C, P]{
+		prover:   prover,
+		verifier: verifier,
+		encoder:  encoder,
+		label:    append([]byte(nil), label...),
+	}
+}
+
+func (n NIZK[C, P]) Prove(x sigma.Word[C, P], w sigma.Witness[C, P]) (Proof, error) {
+	com, decom, err := n.prover.Commit(x, w)
+	if err != nil {
+		return nil, fmt.Errorf("computing commitment: %w", err)
+	}
+	comBytes, err := n.encoder.EncodeCommitment(com)
+	if err != nil {
+		return nil, fmt.Errorf("encoding commitment: %w", err)
+	}
+
+	ch, err := n.challenge(x, comBytes)
+	if err != nil {
+		return nil, fmt.Errorf("deriving challenge: %w", err)
+	}
+	resp := n.prover.Respond(x, w, decom, ch)
+
+	var buf bytes.Buffer
+	if err := writeBytes(&buf, comBytes); err != nil {
+		return nil, fmt.Errorf("writing commitment: %w", err)
+	}
+	if err := writeBytes(&buf, n.encoder.EncodeResponse(resp)); err != nil {
+		return nil, fmt.Errorf("writing response: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Verify checks the proof for word x. It rejects proofs that are not in
+// canonical encoding.
+func (n NIZK[C, P]) Verify(x sigma.Word[C, P], proof Proof) bool {
+	r := bytes.NewReader(proof)
+	comBytes, err := readBytes(r)
+	if err != nil {
+		return false
+	}
+	respBytes, err := readBytes(r)
+	if err != nil || r.Len() != 0 {
+		return false
+	}
+
+	com, err := n.encoder.DecodeCommitment(comBytes)
+	if err != nil {
+		return false
+	}
+	resp := n.encoder.DecodeResponse(respBytes)
+	if !bytes.Equal(n.encoder.EncodeResponse(resp), respBytes) {
+		return false
+	}
+
+	ch, err := n.challenge(x, comBytes)
+	if err != nil {
+		return false
+	}
+	return n.verifier.Verify(x, com, ch, resp)
+}
+
+// challenge derives the challenge from the label, the word and the
+// commitment.
+func (n NIZK[C, P]) challenge(x sigma.Word[C, P], com []byte) (sigma.Challenge[C, P], error) {
+	xBytes, err := n.encoder.EncodeWord(x)
+	if err != nil {
+		return nil, fmt.Errorf("encoding word: %w", err)
+	}
+
+	var buf bytes.Buffer
+	for _, b := range [][]byte{n.label, xBytes, com} {
+		if err := writeBytes(&buf, b); err != nil {
+			return nil, fmt.Errorf("writing transcript: %w", err)
+		}
+	}
+	return n.encoder.HashToChallenge(challengeDST, buf.Bytes()), nil
+}
+
+// writeBytes writes b prefixed with its length.
+func writeBytes(w io.Writer, b []byte) error {
+	if err := binary.Write(w, binary.BigEndian, int64(len(b))); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+// readBytes reads a length-prefixed byte slice.
+func readBytes(r *bytes.Reader) ([]byte, error) {
+	var l int64
+	if err := binary.Read(r, binary.BigEndian, &l); err != nil {
+		return nil, err
+	}
+	if l < 0 || l > int64(r.Len()) {
+		return nil, fmt.Errorf("invalid length")
+	}
+	b := make([]byte, l)
+	_, err := io.ReadFull(r, b)
+	return b, err
+}
@@ -0,0 +1,79 @@
+package nizk_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve/p256"
+	"github.com/matthiasgeihs/go-curve/curve/ristretto255"
+	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
+	"github.com/matthiasgeihs/go-curve/sigma/dlog"
+	"github.com/matthiasgeihs/go-curve/sigma/nizk"
+)
+
+var _ nizk.Encoder[secp256k1.Curve, dlog.Protocol] = dlog.Encoder[secp256k1.Curve]{}
+
+func TestDlog_secp256k1(t *testing.T) {
+	testDlog[secp256k1.Curve](t, secp256k1.NewGenerator())
+}
+
+func TestDlog_edwards25519(t *testing.T) {
+	testDlog[edwards25519.Curve](t, edwards25519.NewGenerator())
+}
+
+func TestDlog_ristretto255(t *testing.T) {
+	testDlog[ristretto255.Curve](t, ristretto255.NewGenerator())
+}
+
+func TestDlog_p256(t *testing.T) {
+	testDlog[p256.Curve](t, p256.NewGenerator())
+}
+
+func testDlog[C curve.Curve](t *testing.T, g curve.Generator[C]) {
+	rnd := rand.Reader
+	newNIZK := func(label string) nizk.NIZK[C, dlog.Protocol] {
+		return nizk.New[C, dlog.Protocol](
+			dlog.NewProver(g, rnd),
+			dlog.NewVerifier(g, rnd),
+			dlog.NewEncoder(g),
+			[]byte(label),
+		)
+	}
+	n := newNIZK("key ownership")
+
+	w, err := g.RandomScalar(rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := g.MulBase(w)
+	proof, err := n.Prove(dlog.Word[C](x), dlog.Witness[C](w))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !n.Verify(dlog.Word[C](x), proof) {
+		t.Error("proof should verify")
+	}
+	if newNIZK("other").Verify(dlog.Word[C](x), proof) {
+		t.Error("proof should not verify under a different label")
+	}
+	if n.Verify(dlog.Word[C](x.Double()), proof) {
+		t.Error("proof should not verify for a different word")
+	}
+
+	for i := range proof {
+		tampered := append(nizk.Proof(nil), proof...)
+		tampered[i] ^= 1
+		if n.Verify(dlog.Word[C](x), tampered) {
+			t.Errorf("proof with byte %d flipped should not verify", i)
+		}
+	}
+	if n.Verify(dlog.Word[C](x), proof[:len(proof)-1]) {
+		t.Error("truncated proof should not verify")
+	}
+	if n.Verify(dlog.Word[C](x), append(proof, 0)) {
+		t.Error("extended proof should not verify")
+	}
+}
@@ -0,0 +1,132 @@
+package cd00
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	sigma "github.com/matthiasgeihs/go-curve/sigma/binary"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
+)
+
+// DecryptionProof is publicly verifiable evidence of the outcome of
+// decrypting a ciphertext. It contains a decryption proof for each encrypted
+// response the decrypter tried: if decryption succeeded, the last one yields a
+// valid response, from which anyone can extract the witness; otherwise, none
+// of the encrypted responses yields a valid response. Encrypted responses
+// that are not bound to the label of the ciphertext get an empty proof, so
+// that moving them from a ciphertext under a different label reveals nothing
+// about them.
+type DecryptionProof[E probenc.Scheme] struct {
+	proofs []probenc.DecryptionProof[E]
+}
+
+// ProveDecryption decrypts the ciphertext like Decrypt and proves the outcome,
+// including decryption failure. The probenc decrypter must implement
+// probenc.DecryptionProver, which only reveals information about encrypted
+// responses that are bound to the label.
+func (d *Decrypter[G, P, E]) ProveDecryption(
+	rnd io.Reader,
+	ct Ciphertext[G, P, E],
+	x sigma.Word[G, P],
+	label []byte,
+) (DecryptionProof[E], error) {
+	prover, ok := d.decrypter.(probenc.DecryptionProver[E])
+	if !ok {
+		return DecryptionProof[E]{}, fmt.Errorf("decryption proofs not supported")
+	}
+	if !bytes.Equal(ct.label, label) {
+		return DecryptionProof[E]{}, fmt.Errorf("label mismatch")
+	}
+
+	var proofs []probenc.DecryptionProof[E]
+	for i, e := range ct.e {
+		proof, err := prover.ProveDecryption(rnd, e, label)
+		if err != nil {
+			return DecryptionProof[E]{}, fmt.Errorf("proving decryption of entry %d: %w", i, err)
+		}
+		proofs = append(proofs, proof)
+
+		s0Bytes, err := d.decrypter.Decrypt(e, label)
+		if err != nil {
+			continue
+		}
+		if d.ver.Verify(x, ct.t[i], false, d.encoder.DecodeResponse(s0Bytes)) {
+			break
+		}
+	}
+	return DecryptionProof[E]{proofs}, nil
+}
+
+// Auditor checks decryption proofs using public information only.
+type Auditor[G curve.Curve, P sigma.Protocol, E probenc.Scheme] struct {
+	ver      sigma.Verifier[G, P]
+	ext      sigma.Extractor[G, P]
+	encoder  sigma.Encoder[G, P]
+	verifier probenc.DecryptionVerifier[E]
+}
+
+func NewAuditor[
+	G curve.Curve,
+	P sigma.Protocol,
+	E probenc.Scheme,
+](
+	ver sigma.Verifier[G, P],
+	ext sigma.Extractor[G, P],
+	encoder sigma.Encoder[G, P],
+	verifier probenc.DecryptionVerifier[E],
+) *Auditor[G, P, E] {
+	return &Auditor[G, P, E]{
+		ver:      ver,
+		ext:      ext,
+		encoder:  encoder,
+		verifier: verifier,
+	}
+}
+
+// VerifyDecryption checks the decryption proof for the ciphertext and returns
+// the witness for x. It returns ErrDecryptionFailure if the proof shows that
+// no encrypted response decrypts to a valid response, and a different error if
+// the proof is invalid. The empty proofs of encrypted responses that are not
+// bound to the label show failure, as the probenc verifier checks publicly
+// that they are not bound to the label.
+func (a *Auditor[G, P, E]) VerifyDecryption(
+	ct Ciphertext[G, P, E],
+	x sigma.Word[G, P],
+	label []byte,
+	proof DecryptionProof[E],
+) (sigma.Witness[G, P], error) {
+	if !bytes.Equal(ct.label, label) {
+		return nil, fmt.Errorf("label mismatch")
+	}
+	if len(proof.proofs) > len(ct.e) {
+		return nil, fmt.Errorf("invalid proof length: %d", len(proof.proofs))
+	}
+	for i, pr := range proof.proofs {
+		s0Bytes, ok, err := a.verifier.VerifyDecryption(ct.e[i], label, pr)
+		if err != nil {
+			return nil, fmt.Errorf("verifying decryption of entry %d: %w", i, err)
+		}
+		if !ok {
+			continue
+		}
+		s0 := a.encoder.DecodeResponse(s0Bytes)
+		if !a.ver.Verify(x, ct.t[i], false, s0) {
+			continue
+		}
+		if i != len(proof.proofs)-1 {
+			return nil, fmt.Errorf("proof continues after valid entry %d", i)
+		}
+
+		// The valid response for challenge 0 and the response for challenge 1
+		// form a proof of knowledge of the witness.
+		tr0 := sigma.MakeTranscript(false, s0)
+		tr1 := sigma.MakeTranscript(true, ct.s[i])
+		return a.ext.Extract(tr0, tr1), nil
+	}
+	if len(proof.proofs) != len(ct.e) {
+		return nil, fmt.Errorf("incomplete proof")
+	}
+	return nil, ErrDecryptionFailure
+}
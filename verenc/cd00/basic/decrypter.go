@@ -1,6 +1,7 @@
 package basic
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/matthiasgeihs/go-curve/curve"
@@ -29,11 +30,17 @@ func NewDecrypter[C curve.Curve, P sigma.Protocol, E probenc.Scheme](
 	}
 }
 
+// Decrypt decrypts the ciphertext and returns the witness for x. It refuses
+// ciphertexts that are not bound to the given label.
 func (d Decrypter[C, P, E]) Decrypt(
 	ct Ciphertext[C, P, E],
 	x sigma.Word[C, P],
+	label []byte,
 ) (sigma.Witness[C, P], error) {
-	decBytes, err := d.decrypter.Decrypt(ct.e)
+	if !bytes.Equal(ct.label, label) {
+		return nil, fmt.Errorf("label mismatch")
+	}
+	decBytes, err := d.decrypter.Decrypt(ct.e, label)
 	if err != nil {
 		return nil, fmt.Errorf("decrypting: %w", err)
 	}
@@ -1,4 +1,9 @@
 // basic implements the basic verifiable encryption scheme from Camenisch and
 // Damgard, "Verifiable Encryption, Group Encryption, and Their Applications to
 // Separable Group Signatures and Signature Sharing Schemes", ASIACRYPT 2000.
+//
+// Commitments, responses and ciphertexts implement encoding.BinaryMarshaler
+// and encoding.BinaryUnmarshaler. A Decommitment has no encoding, as it is the
+// private state of the prover between Commit and Respond and contains the
+// witness.
 package basic
@@ -0,0 +1,212 @@
+package basic
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/internal/wire"
+)
+
+// Messages carry the encoder of the party that created them. Use
+// Verifier.NewCommitment, Verifier.NewResponse and Decrypter.NewCiphertext to
+// obtain messages to unmarshal into.
+
+func (com Commitment[C, P, E]) MarshalBinary() ([]byte, error) {
+	if com.encoder == nil {
+		return nil, fmt.Errorf("missing encoder")
+	}
+
+	var buf bytes.Buffer
+	err := wire.WriteVersion(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("writing version: %w", err)
+	}
+	err = wire.WriteCommitment(&buf, com.encoder, com.t)
+	if err != nil {
+		return nil, err
+	}
+	for i, e := range com.e {
+		err = wire.WriteBytes(&buf, e)
+		if err != nil {
+			return nil, fmt.Errorf("writing ciphertext %d: %w", i, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a commitment. The receiver must have been created by
+// Verifier.NewCommitment.
+func (com *Commitment[C, P, E]) UnmarshalBinary(data []byte) error {
+	if com.encoder == nil {
+		return fmt.Errorf("missing encoder")
+	}
+
+	r := bytes.NewReader(data)
+	err := wire.ReadVersion(r)
+	if err != nil {
+		return err
+	}
+	t, err := wire.ReadCommitment(r, com.encoder)
+	if err != nil {
+		return err
+	}
+	var e [2][]byte
+	for i := range e {
+		e[i], err = wire.ReadBytes(r)
+		if err != nil {
+			return fmt.Errorf("reading ciphertext %d: %w", i, err)
+		}
+	}
+	err = wire.ReadEnd(r)
+	if err != nil {
+		return err
+	}
+	com.t = t
+	com.e[0], com.e[1] = e[0], e[1]
+	return nil
+}
+
+// NewCommitment returns an empty commitment to unmarshal into.
+func (v Verifier[C, P, E]) NewCommitment() *Commitment[C, P, E] {
+	return &Commitment[C, P, E]{
+		encoder: v.encoder,
+	}
+}
+
+func (resp Response[C, P, E]) MarshalBinary() ([]byte, error) {
+	if resp.encoder == nil {
+		return nil, fmt.Errorf("missing encoder")
+	}
+
+	var buf bytes.Buffer
+	err := wire.WriteVersion(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("writing version: %w", err)
+	}
+	err = wire.WriteBytes(&buf, resp.r)
+	if err != nil {
+		return nil, fmt.Errorf("writing randomness: %w", err)
+	}
+	err = wire.WriteResponse(&buf, resp.encoder, resp.s)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a response. The receiver must have been created by
+// Verifier.NewResponse.
+func (resp *Response[C, P, E]) UnmarshalBinary(data []byte) error {
+	if resp.encoder == nil {
+		return fmt.Errorf("missing encoder")
+	}
+
+	r := bytes.NewReader(data)
+	err := wire.ReadVersion(r)
+	if err != nil {
+		return err
+	}
+	rnd, err := wire.ReadBytes(r)
+	if err != nil {
+		return fmt.Errorf("reading randomness: %w", err)
+	}
+	s, err := wire.ReadResponse(r, resp.encoder)
+	if err != nil {
+		return err
+	}
+	err = wire.ReadEnd(r)
+	if err != nil {
+		return err
+	}
+	resp.r, resp.s = rnd, s
+	return nil
+}
+
+// NewResponse returns an empty response to unmarshal into.
+func (v Verifier[C, P, E]) NewResponse() *Response[C, P, E] {
+	return &Response[C, P, E]{
+		encoder: v.encoder,
+	}
+}
+
+func (ct Ciphertext[C, P, E]) MarshalBinary() ([]byte, error) {
+	if ct.encoder == nil {
+		return nil, fmt.Errorf("missing encoder")
+	}
+
+	var buf bytes.Buffer
+	err := wire.WriteVersion(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("writing version: %w", err)
+	}
+	err = wire.WriteBytes(&buf, ct.label)
+	if err != nil {
+		return nil, fmt.Errorf("writing label: %w", err)
+	}
+	err = wire.WriteCommitment(&buf, ct.encoder, ct.t)
+	if err != nil {
+		return nil, err
+	}
+	err = buf.WriteByte(byte(chtoi(ct.c)))
+	if err != nil {
+		return nil, fmt.Errorf("writing challenge: %w", err)
+	}
+	err = wire.WriteBytes(&buf, ct.e)
+	if err != nil {
+		return nil, fmt.Errorf("writing ciphertext: %w", err)
+	}
+	err = wire.WriteResponse(&buf, ct.encoder, ct.s)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a ciphertext. The receiver must have been created
+// by Decrypter.NewCiphertext.
+func (ct *Ciphertext[C, P, E]) UnmarshalBinary(data []byte) error {
+	if ct.encoder == nil {
+		return fmt.Errorf("missing encoder")
+	}
+
+	r := bytes.NewReader(data)
+	err := wire.ReadVersion(r)
+	if err != nil {
+		return err
+	}
+	label, err := wire.ReadBytes(r)
+	if err != nil {
+		return fmt.Errorf("reading label: %w", err)
+	}
+	t, err := wire.ReadCommitment(r, ct.encoder)
+	if err != nil {
+		return err
+	}
+	c, err := r.ReadByte()
+	if err != nil {
+		return fmt.Errorf("reading challenge: %w", err)
+	} else if c > 1 {
+		return fmt.Errorf("invalid challenge: %d", c)
+	}
+	e, err := wire.ReadBytes(r)
+	if err != nil {
+		return fmt.Errorf("reading ciphertext: %w", err)
+	}
+	s, err := wire.ReadResponse(r, ct.encoder)
+	if err != nil {
+		return err
+	}
+	err = wire.ReadEnd(r)
+	if err != nil {
+		return err
+	}
+	ct.label, ct.t, ct.c, ct.e, ct.s = label, t, c == 1, e, s
+	return nil
+}
+
+// NewCiphertext returns an empty ciphertext to unmarshal into.
+func (d Decrypter[C, P, E]) NewCiphertext() *Ciphertext[C, P, E] {
+	return &Ciphertext[C, P, E]{
+		encoder: d.encoder,
+	}
+}
@@ -12,6 +12,7 @@ import (
 	sigma "github.com/matthiasgeihs/go-curve/sigma/binary"
 	dlog "github.com/matthiasgeihs/go-curve/sigma/dlog/binary"
 	cd00 "github.com/matthiasgeihs/go-curve/verenc/cd00/basic"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/internal/wiretest"
 	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
 	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc/rsa"
 )
@@ -89,30 +90,44 @@ func runProtocol[C curve.Curve, P sigma.Protocol, E probenc.Scheme](
 	encoder sigma.Encoder[C, P],
 	securityLevel uint,
 ) {
+	label := []byte("transaction")
+
 	// Run protocol repeatedly to increase chance of catching cheating prover.
 	ct := make([]cd00.Ciphertext[C, P, E], securityLevel)
 	for i := uint(0); i < securityLevel; i++ {
-		com, decom, err := p.Commit(x, w)
+		// Each message is sent over the wire to check the binary encoding.
+		com, decom, err := p.Commit(x, w, label)
 		if err != nil {
 			t.Fatal(err)
 		}
+		comRecv := v.NewCommitment()
+		wiretest.Transmit(t, com, comRecv)
 
-		ch, err := v.Challenge(com)
+		ch, err := v.Challenge(*comRecv)
 		if err != nil {
 			t.Fatal(err)
 		}
 
 		resp := p.Respond(decom, ch)
-		ct[i], err = v.Verify(x, com, ch, resp)
+		respRecv := v.NewResponse()
+		wiretest.Transmit(t, resp, respRecv)
+
+		ctSent, err := v.Verify(x, label, *comRecv, ch, *respRecv)
 		if err != nil {
 			t.Error(err)
+			continue
 		}
+		ct[i] = *d.NewCiphertext()
+		wiretest.Transmit(t, ctSent, &ct[i])
 	}
 
 	// Decrypt.
 	wDec := func() sigma.Witness[C, P] {
 		for _, cti := range ct {
-			wDec, err := d.Decrypt(cti, x)
+			if _, err := d.Decrypt(cti, x, []byte("other")); err == nil {
+				t.Error("should refuse decryption under a different label")
+			}
+			wDec, err := d.Decrypt(cti, x, label)
 			if err == nil {
 				return wDec
 			}
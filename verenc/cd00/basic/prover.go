@@ -17,16 +17,18 @@ type Prover[C curve.Curve, P sigma.Protocol, E probenc.Scheme] struct {
 	rnd       io.Reader
 }
 type Commitment[C curve.Curve, P sigma.Protocol, E probenc.Scheme] struct {
-	t sigma.Commitment[C, P]
-	e [2]probenc.Ciphertext[E]
+	t       sigma.Commitment[C, P]
+	e       [2]probenc.Ciphertext[E]
+	encoder sigma.Encoder[C, P]
 }
 type Decommitment[C curve.Curve, P sigma.Protocol, E probenc.Scheme] struct {
 	r [2]probenc.RandomBytes
 	s [2]sigma.Response[C, P]
 }
 type Response[C curve.Curve, P sigma.Protocol, E probenc.Scheme] struct {
-	r probenc.RandomBytes
-	s sigma.Response[C, P]
+	r       probenc.RandomBytes
+	s       sigma.Response[C, P]
+	encoder sigma.Encoder[C, P]
 }
 
 func NewProver[C curve.Curve, P sigma.Protocol, E probenc.Scheme](
@@ -45,9 +47,11 @@ func NewProver[C curve.Curve, P sigma.Protocol, E probenc.Scheme](
 	}
 }
 
+// Commit encrypts both responses under the given label.
 func (p Prover[C, P, E]) Commit(
 	x sigma.Word[C, P],
 	w sigma.Witness[C, P],
+	label []byte,
 ) (
 	Commitment[C, P, E],
 	Decommitment[C, P, E],
@@ -61,11 +65,11 @@ func (p Prover[C, P, E]) Commit(
 	ch0, ch1 := sigma.Challenge(false), sigma.Challenge(true)
 	s0, s1 := p.sigmaP.Respond(x, w, rt, ch0), p.sigmaP.Respond(x, w, rt, ch1)
 	s0Bytes, s1Bytes := p.encoder.EncodeResponse(s0), p.encoder.EncodeResponse(s1)
-	e0, r0, err := probenc.Encrypt(p.rnd, s0Bytes, p.encrypter)
+	e0, r0, err := probenc.Encrypt(p.rnd, s0Bytes, label, p.encrypter)
 	if err != nil {
 		return Commitment[C, P, E]{}, Decommitment[C, P, E]{}, fmt.Errorf("encrypting s0: %w", err)
 	}
-	e1, r1, err := probenc.Encrypt(p.rnd, s1Bytes, p.encrypter)
+	e1, r1, err := probenc.Encrypt(p.rnd, s1Bytes, label, p.encrypter)
 	if err != nil {
 		return Commitment[C, P, E]{}, Decommitment[C, P, E]{}, fmt.Errorf("encrypting s1: %w", err)
 	}
@@ -73,6 +77,7 @@ func (p Prover[C, P, E]) Commit(
 	com := Commitment[C, P, E]{
 		t,
 		[2]probenc.Ciphertext[E]{e0, e1},
+		p.encoder,
 	}
 	decom := Decommitment[C, P, E]{
 		[2]probenc.RandomBytes{r0, r1},
@@ -86,7 +91,7 @@ func (p Prover[C, P, E]) Respond(
 	ch sigma.Challenge,
 ) Response[C, P, E] {
 	chi := chtoi(ch)
-	return Response[C, P, E]{decom.r[chi], decom.s[chi]}
+	return Response[C, P, E]{decom.r[chi], decom.s[chi], p.encoder}
 }
 
 func chtoi(ch sigma.Challenge) int {
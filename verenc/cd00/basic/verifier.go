@@ -18,10 +18,12 @@ type Verifier[C curve.Curve, P sigma.Protocol, E probenc.Scheme] struct {
 }
 type Word[C curve.Curve] curve.Point[C]
 type Ciphertext[C curve.Curve, P sigma.Protocol, E probenc.Scheme] struct {
-	t sigma.Commitment[C, P]
-	c sigma.Challenge
-	e probenc.Ciphertext[E]
-	s sigma.Response[C, P]
+	label   []byte
+	t       sigma.Commitment[C, P]
+	c       sigma.Challenge
+	e       probenc.Ciphertext[E]
+	s       sigma.Response[C, P]
+	encoder sigma.Encoder[C, P]
 }
 
 func NewVerifier[C curve.Curve, P sigma.Protocol, E probenc.Scheme](
@@ -53,8 +55,11 @@ func (v Verifier[C, P, E]) Challenge(Commitment[C, P, E]) (sigma.Challenge, erro
 	return sigma.Challenge(c), nil
 }
 
+// Verify verifies the response for x and returns the ciphertext bound to the
+// given label on success.
 func (v Verifier[C, P, E]) Verify(
 	x sigma.Word[C, P],
+	label []byte,
 	com Commitment[C, P, E],
 	ch sigma.Challenge,
 	resp Response[C, P, E],
@@ -68,16 +73,18 @@ func (v Verifier[C, P, E]) Verify(
 	eCh, eCt := com.e[chi], com.e[1-chi]
 	sBytes := v.encoder.EncodeResponse(resp.s)
 	buf := bytes.NewBuffer(resp.r)
-	ctEnc, err := v.encrypter.Encrypt(buf, sBytes)
+	ctEnc, err := v.encrypter.Encrypt(buf, sBytes, label)
 	if err != nil {
 		return Ciphertext[C, P, E]{}, fmt.Errorf("failed to encrypt")
 	} else if !bytes.Equal(eCh, ctEnc) {
 		return Ciphertext[C, P, E]{}, fmt.Errorf("invalid encryption")
 	}
 	return Ciphertext[C, P, E]{
+		append([]byte(nil), label...),
 		com.t,
 		ch,
 		eCt,
 		resp.s,
+		v.encoder,
 	}, nil
 }
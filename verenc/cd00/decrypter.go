@@ -1,6 +1,8 @@
 package cd00
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 
 	"github.com/matthiasgeihs/go-curve/curve"
@@ -8,6 +10,10 @@ import (
 	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
 )
 
+// ErrDecryptionFailure is returned if no encrypted response decrypts to a
+// valid sigma protocol response.
+var ErrDecryptionFailure = errors.New("decryption failure")
+
 type Decrypter[G curve.Curve, P sigma.Protocol, E probenc.Scheme] struct {
 	ver       sigma.Verifier[G, P]
 	ext       sigma.Extractor[G, P]
@@ -33,24 +39,50 @@ func NewDecrypter[
 	}
 }
 
+// Decrypt decrypts the ciphertext and returns the witness for x. It refuses
+// ciphertexts that are not bound to the given label. Because of the label,
+// Decrypter does not implement verenc.Decrypter.
 func (d *Decrypter[C, P, E]) Decrypt(
 	ct Ciphertext[C, P, E],
 	x sigma.Word[C, P],
+	label []byte,
 ) (sigma.Witness[C, P], error) {
+	if !bytes.Equal(ct.label, label) {
+		return nil, fmt.Errorf("label mismatch")
+	}
+	return extract(d.ver, d.ext, d.encoder, ct, x, func(i int) ([]byte, error) {
+		return d.decrypter.Decrypt(ct.e[i], label)
+	})
+}
+
+// extract decrypts the encrypted responses one by one using decrypt until it
+// finds a valid one, from which it extracts the witness. Entries that fail to
+// decrypt are skipped, as a cheating prover controls the unopened entries.
+func extract[G curve.Curve, P sigma.Protocol, E probenc.Scheme](
+	ver sigma.Verifier[G, P],
+	ext sigma.Extractor[G, P],
+	encoder sigma.Encoder[G, P],
+	ct Ciphertext[G, P, E],
+	x sigma.Word[G, P],
+	decrypt func(i int) ([]byte, error),
+) (sigma.Witness[G, P], error) {
+	var decErr error
 	u := len(ct.e)
 	for i := 0; i < u; i++ {
 		// Decrypt sigma response for challenge 0.
-		e := ct.e[i]
-		s0Bytes, err := d.decrypter.Decrypt(e)
+		s0Bytes, err := decrypt(i)
 		if err != nil {
-			return nil, fmt.Errorf("decrypting: %w", err)
+			if decErr == nil {
+				decErr = fmt.Errorf("decrypting entry %d: %w", i, err)
+			}
+			continue
 		}
-		s0 := d.encoder.DecodeResponse(s0Bytes)
+		s0 := encoder.DecodeResponse(s0Bytes)
 		s1 := ct.s[i]
 
 		// Verify sigma response.
 		t := ct.t[i]
-		valid := d.ver.Verify(x, t, false, s0)
+		valid := ver.Verify(x, t, false, s0)
 		if !valid {
 			// Sigma response is invalid. Continue with next entry.
 			continue
@@ -59,8 +91,11 @@ func (d *Decrypter[C, P, E]) Decrypt(
 		// Extract witness.
 		tr0 := sigma.MakeTranscript(false, s0)
 		tr1 := sigma.MakeTranscript(true, s1)
-		w := d.ext.Extract(tr0, tr1)
+		w := ext.Extract(tr0, tr1)
 		return w, nil
 	}
-	return nil, fmt.Errorf("decryption failure")
+	if decErr != nil {
+		return nil, fmt.Errorf("%w: %v", ErrDecryptionFailure, decErr)
+	}
+	return nil, ErrDecryptionFailure
 }
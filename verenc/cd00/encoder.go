@@ -2,11 +2,12 @@ package cd00
 
 import (
 	"bytes"
-	"encoding/binary"
 	"fmt"
+	"io"
 
 	"github.com/matthiasgeihs/go-curve/curve"
 	sigma "github.com/matthiasgeihs/go-curve/sigma/binary"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/internal/wire"
 	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
 )
 
@@ -24,29 +25,151 @@ func (e *encoder[G, P, E]) EncodeEncryptedResponses(resps []EncryptedResponse[G,
 	var buf bytes.Buffer
 
 	// Write length.
-	err := binary.Write(&buf, binary.BigEndian, int64(len(resps)))
+	err := wire.WriteLength(&buf, len(resps))
 	if err != nil {
 		return nil, fmt.Errorf("writing length: %w", err)
 	}
 
 	// Write elements.
 	for i, r := range resps {
-		// Write commitment.
-		commBytes, err := e.EncodeCommitment(r.t)
+		err := e.writeEncryptedResponse(&buf, r)
 		if err != nil {
-			return nil, fmt.Errorf("encoding commitment %d: %w", i, err)
+			return nil, fmt.Errorf("writing encrypted response %d: %w", i, err)
 		}
-		err = binary.Write(&buf, binary.BigEndian, commBytes)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// encodeCommitted encodes the data the prover commits to, which consists of
+// the label and the encrypted responses.
+func (e *encoder[G, P, E]) encodeCommitted(label []byte, resps []EncryptedResponse[G, P, E]) ([]byte, error) {
+	var buf bytes.Buffer
+	err := wire.WriteBytes(&buf, label)
+	if err != nil {
+		return nil, fmt.Errorf("writing label: %w", err)
+	}
+	data, err := e.EncodeEncryptedResponses(resps)
+	if err != nil {
+		return nil, fmt.Errorf("encoding encrypted responses: %w", err)
+	}
+	buf.Write(data)
+	return buf.Bytes(), nil
+}
+
+func (e *encoder[G, P, E]) writeEncryptedResponse(w io.Writer, r EncryptedResponse[G, P, E]) error {
+	err := wire.WriteCommitment[G, P](w, e, r.t)
+	if err != nil {
+		return err
+	}
+	err = wire.WriteBytes(w, r.e)
+	if err != nil {
+		return fmt.Errorf("writing ciphertext: %w", err)
+	}
+	return nil
+}
+
+func (e *encoder[G, P, E]) readEncryptedResponse(r *bytes.Reader) (EncryptedResponse[G, P, E], error) {
+	t, err := wire.ReadCommitment[G, P](r, e)
+	if err != nil {
+		return EncryptedResponse[G, P, E]{}, err
+	}
+	ct, err := wire.ReadBytes(r)
+	if err != nil {
+		return EncryptedResponse[G, P, E]{}, fmt.Errorf("reading ciphertext: %w", err)
+	}
+	return EncryptedResponse[G, P, E]{t, ct}, nil
+}
+
+// writeProofResponse writes the encrypted responses, the sigma responses and
+// the encryption randomness of the unopened responses.
+func (e *encoder[G, P, E]) writeProofResponse(w io.Writer, resp proofResponse[G, P, E]) error {
+	if len(resp.s) != len(resp.encResps) || len(resp.r) != len(resp.encResps) {
+		return fmt.Errorf("inconsistent lengths")
+	}
+
+	// Write length.
+	err := wire.WriteLength(w, len(resp.encResps))
+	if err != nil {
+		return fmt.Errorf("writing length: %w", err)
+	}
+
+	// Write elements.
+	for i := range resp.encResps {
+		err := e.writeEncryptedResponse(w, resp.encResps[i])
+		if err != nil {
+			return fmt.Errorf("writing encrypted response %d: %w", i, err)
+		}
+		err = wire.WriteResponse[G, P](w, e, resp.s[i])
 		if err != nil {
-			return nil, fmt.Errorf("writing commitment %d: %w", i, err)
+			return fmt.Errorf("writing response %d: %w", i, err)
 		}
+		err = wire.WriteBytes(w, resp.r[i])
+		if err != nil {
+			return fmt.Errorf("writing randomness %d: %w", i, err)
+		}
+	}
+	return nil
+}
 
-		// Write encryption.
-		err = binary.Write(&buf, binary.BigEndian, r.e)
+func (e *encoder[G, P, E]) readProofResponse(r *bytes.Reader) (proofResponse[G, P, E], error) {
+	// Read length.
+	k, err := wire.ReadLength(r)
+	if err != nil {
+		return proofResponse[G, P, E]{}, fmt.Errorf("reading length: %w", err)
+	}
+
+	// Read elements.
+	resp := proofResponse[G, P, E]{
+		encResps: make([]EncryptedResponse[G, P, E], k),
+		s:        make([]sigma.Response[G, P], k),
+		r:        make([]probenc.RandomBytes, k),
+	}
+	for i := range resp.encResps {
+		resp.encResps[i], err = e.readEncryptedResponse(r)
+		if err != nil {
+			return proofResponse[G, P, E]{}, fmt.Errorf("reading encrypted response %d: %w", i, err)
+		}
+		resp.s[i], err = wire.ReadResponse[G, P](r, e)
+		if err != nil {
+			return proofResponse[G, P, E]{}, fmt.Errorf("reading response %d: %w", i, err)
+		}
+		resp.r[i], err = wire.ReadBytes(r)
 		if err != nil {
-			return nil, fmt.Errorf("writing ciphertext %d: %w", i, err)
+			return proofResponse[G, P, E]{}, fmt.Errorf("reading randomness %d: %w", i, err)
 		}
 	}
+	return resp, nil
+}
 
+// EncodeProof encodes a non-interactive proof.
+func (e *encoder[G, P, E]) EncodeProof(resp proofResponse[G, P, E]) ([]byte, error) {
+	var buf bytes.Buffer
+	err := wire.WriteVersion(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("writing version: %w", err)
+	}
+	err = e.writeProofResponse(&buf, resp)
+	if err != nil {
+		return nil, err
+	}
 	return buf.Bytes(), nil
 }
+
+// DecodeProof decodes a non-interactive proof. It rejects malformed input.
+func (e *encoder[G, P, E]) DecodeProof(data []byte) (proofResponse[G, P, E], error) {
+	r := bytes.NewReader(data)
+	err := wire.ReadVersion(r)
+	if err != nil {
+		return proofResponse[G, P, E]{}, err
+	}
+	resp, err := e.readProofResponse(r)
+	if err != nil {
+		return proofResponse[G, P, E]{}, err
+	}
+	err = wire.ReadEnd(r)
+	if err != nil {
+		return proofResponse[G, P, E]{}, err
+	}
+	return resp, nil
+}
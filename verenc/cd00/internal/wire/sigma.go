@@ -0,0 +1,71 @@
+package wire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	sigma "github.com/matthiasgeihs/go-curve/sigma/binary"
+)
+
+// WriteCommitment writes a length-prefixed sigma commitment.
+func WriteCommitment[C curve.Curve, P sigma.Protocol](
+	w io.Writer,
+	enc sigma.Encoder[C, P],
+	t sigma.Commitment[C, P],
+) error {
+	tBytes, err := enc.EncodeCommitment(t)
+	if err != nil {
+		return fmt.Errorf("encoding commitment: %w", err)
+	}
+	err = WriteBytes(w, tBytes)
+	if err != nil {
+		return fmt.Errorf("writing commitment: %w", err)
+	}
+	return nil
+}
+
+func ReadCommitment[C curve.Curve, P sigma.Protocol](
+	r *bytes.Reader,
+	enc sigma.Encoder[C, P],
+) (sigma.Commitment[C, P], error) {
+	tBytes, err := ReadBytes(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading commitment: %w", err)
+	}
+	t, err := enc.DecodeCommitment(tBytes)
+	if err != nil {
+		return nil, fmt.Errorf("decoding commitment: %w", err)
+	}
+	return t, nil
+}
+
+// WriteResponse writes a length-prefixed sigma response.
+func WriteResponse[C curve.Curve, P sigma.Protocol](
+	w io.Writer,
+	enc sigma.Encoder[C, P],
+	s sigma.Response[C, P],
+) error {
+	err := WriteBytes(w, enc.EncodeResponse(s))
+	if err != nil {
+		return fmt.Errorf("writing response: %w", err)
+	}
+	return nil
+}
+
+// ReadResponse reads a sigma response. It rejects non-canonical encodings.
+func ReadResponse[C curve.Curve, P sigma.Protocol](
+	r *bytes.Reader,
+	enc sigma.Encoder[C, P],
+) (sigma.Response[C, P], error) {
+	sBytes, err := ReadBytes(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading response: %w", err)
+	}
+	s := enc.DecodeResponse(sBytes)
+	if !bytes.Equal(enc.EncodeResponse(s), sBytes) {
+		return nil, fmt.Errorf("non-canonical response")
+	}
+	return s, nil
+}
@@ -0,0 +1,88 @@
+// wire implements the primitives of the versioned binary encoding of the
+// messages of cd00 and its sub-packages. A message starts with a version byte,
+// followed by its fields. Variable-length fields are prefixed with their
+// length as a 64-bit big-endian integer.
+package wire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Version is the current version of the encoding.
+const Version byte = 2
+
+func WriteVersion(w io.Writer) error {
+	_, err := w.Write([]byte{Version})
+	return err
+}
+
+// ReadVersion reads the version byte and checks that it is supported.
+func ReadVersion(r *bytes.Reader) error {
+	v, err := r.ReadByte()
+	if err != nil {
+		return fmt.Errorf("reading version: %w", err)
+	} else if v != Version {
+		return fmt.Errorf("unsupported version: %d", v)
+	}
+	return nil
+}
+
+func WriteLength(w io.Writer, l int) error {
+	return binary.Write(w, binary.BigEndian, int64(l))
+}
+
+// ReadLength reads a length and checks that it does not exceed the number of
+// remaining bytes, which bounds allocations for malformed input.
+func ReadLength(r *bytes.Reader) (int, error) {
+	var l int64
+	err := binary.Read(r, binary.BigEndian, &l)
+	if err != nil {
+		return 0, err
+	}
+	if l < 0 || l > int64(r.Len()) {
+		return 0, fmt.Errorf("invalid length: %d", l)
+	}
+	return int(l), nil
+}
+
+func WriteUint(w io.Writer, v uint64) error {
+	return binary.Write(w, binary.BigEndian, v)
+}
+
+func ReadUint(r *bytes.Reader) (uint64, error) {
+	var v uint64
+	err := binary.Read(r, binary.BigEndian, &v)
+	return v, err
+}
+
+// WriteBytes writes b prefixed with its length.
+func WriteBytes(w io.Writer, b []byte) error {
+	err := WriteLength(w, len(b))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// ReadBytes reads a length-prefixed byte slice.
+func ReadBytes(r *bytes.Reader) ([]byte, error) {
+	l, err := ReadLength(r)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, l)
+	_, err = io.ReadFull(r, b)
+	return b, err
+}
+
+// ReadEnd checks that all input has been consumed.
+func ReadEnd(r *bytes.Reader) error {
+	if r.Len() != 0 {
+		return fmt.Errorf("%d bytes of trailing data", r.Len())
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+// wiretest provides test helpers for the binary encoding of the messages of
+// cd00 and its sub-packages.
+package wiretest
+
+import (
+	"encoding"
+	"testing"
+)
+
+// Transmit marshals m, checks that malformed encodings are rejected and
+// unmarshals the encoding into recv.
+func Transmit(t testing.TB, m encoding.BinaryMarshaler, recv encoding.BinaryUnmarshaler) {
+	t.Helper()
+	data, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := recv.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Error("should reject truncated input")
+	}
+	if err := recv.UnmarshalBinary(append(data, 0)); err == nil {
+		t.Error("should reject trailing data")
+	}
+	invalidVersion := append([]byte{0xff}, data[1:]...)
+	if err := recv.UnmarshalBinary(invalidVersion); err == nil {
+		t.Error("should reject unknown version")
+	}
+	if err := recv.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+}
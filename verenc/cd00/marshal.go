@@ -0,0 +1,323 @@
+package cd00
+
+import (
+	"bytes"
+	"encoding"
+	"fmt"
+
+	"github.com/matthiasgeihs/go-curve/commit"
+	sigma "github.com/matthiasgeihs/go-curve/sigma/binary"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/internal/wire"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
+)
+
+// Messages containing sigma protocol elements carry the encoder of the party
+// that created them. Use Verifier.NewResponse and Decrypter.NewCiphertext to
+// obtain messages to unmarshal into.
+
+// Check that types implement interfaces.
+var _ encoding.BinaryMarshaler = Commitment[commit.Scheme](nil)
+var _ encoding.BinaryUnmarshaler = (*Commitment[commit.Scheme])(nil)
+var _ encoding.BinaryMarshaler = Challenge(nil)
+var _ encoding.BinaryUnmarshaler = (*Challenge)(nil)
+var _ encoding.BinaryMarshaler = DecryptionShare[probenc.Scheme]{}
+var _ encoding.BinaryUnmarshaler = (*DecryptionShare[probenc.Scheme])(nil)
+var _ encoding.BinaryMarshaler = DecryptionProof[probenc.Scheme]{}
+var _ encoding.BinaryUnmarshaler = (*DecryptionProof[probenc.Scheme])(nil)
+
+func (c Commitment[C]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := wire.WriteVersion(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("writing version: %w", err)
+	}
+	err = wire.WriteBytes(&buf, c)
+	if err != nil {
+		return nil, fmt.Errorf("writing commitment: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (c *Commitment[C]) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	err := wire.ReadVersion(r)
+	if err != nil {
+		return err
+	}
+	b, err := wire.ReadBytes(r)
+	if err != nil {
+		return fmt.Errorf("reading commitment: %w", err)
+	}
+	err = wire.ReadEnd(r)
+	if err != nil {
+		return err
+	}
+	*c = b
+	return nil
+}
+
+func (ch Challenge) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := wire.WriteVersion(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("writing version: %w", err)
+	}
+	err = wire.WriteLength(&buf, len(ch))
+	if err != nil {
+		return nil, fmt.Errorf("writing length: %w", err)
+	}
+	for i, c := range ch {
+		err = wire.WriteUint(&buf, uint64(c))
+		if err != nil {
+			return nil, fmt.Errorf("writing index %d: %w", i, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a challenge. It rejects repeated indices. Whether
+// the indices are in range is checked by Prover.Respond.
+func (ch *Challenge) UnmarshalBinary(data []byte) error {
+	r := bytes.NewReader(data)
+	err := wire.ReadVersion(r)
+	if err != nil {
+		return err
+	}
+	l, err := wire.ReadLength(r)
+	if err != nil {
+		return fmt.Errorf("reading length: %w", err)
+	}
+	c := make(Challenge, l)
+	seen := make(map[uint]bool, l)
+	for i := range c {
+		idx, err := wire.ReadUint(r)
+		if err != nil {
+			return fmt.Errorf("reading index %d: %w", i, err)
+		} else if idx > uint64(^uint(0)) || seen[uint(idx)] {
+			return fmt.Errorf("invalid index %d", idx)
+		}
+		c[i] = uint(idx)
+		seen[c[i]] = true
+	}
+	err = wire.ReadEnd(r)
+	if err != nil {
+		return err
+	}
+	*ch = c
+	return nil
+}
+
+func (resp Response[G, P, E, C]) MarshalBinary() ([]byte, error) {
+	if resp.encoder == nil {
+		return nil, fmt.Errorf("missing encoder")
+	}
+
+	var buf bytes.Buffer
+	err := wire.WriteVersion(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("writing version: %w", err)
+	}
+	err = wire.WriteBytes(&buf, resp.d)
+	if err != nil {
+		return nil, fmt.Errorf("writing decommitment: %w", err)
+	}
+	err = resp.encoder.writeProofResponse(&buf, resp.proofResponse)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a response. The receiver must have been created by
+// Verifier.NewResponse.
+func (resp *Response[G, P, E, C]) UnmarshalBinary(data []byte) error {
+	if resp.encoder == nil {
+		return fmt.Errorf("missing encoder")
+	}
+
+	r := bytes.NewReader(data)
+	err := wire.ReadVersion(r)
+	if err != nil {
+		return err
+	}
+	d, err := wire.ReadBytes(r)
+	if err != nil {
+		return fmt.Errorf("reading decommitment: %w", err)
+	}
+	pr, err := resp.encoder.readProofResponse(r)
+	if err != nil {
+		return err
+	}
+	err = wire.ReadEnd(r)
+	if err != nil {
+		return err
+	}
+	resp.proofResponse, resp.d = pr, d
+	return nil
+}
+
+// NewResponse returns an empty response to unmarshal into.
+func (v *Verifier[G, P, E, C]) NewResponse() *Response[G, P, E, C] {
+	return &Response[G, P, E, C]{
+		encoder: v.encoder,
+	}
+}
+
+func (ct Ciphertext[G, P, E]) MarshalBinary() ([]byte, error) {
+	if ct.encoder == nil {
+		return nil, fmt.Errorf("missing encoder")
+	} else if len(ct.s) != len(ct.t) || len(ct.e) != len(ct.t) {
+		return nil, fmt.Errorf("inconsistent lengths")
+	}
+
+	var buf bytes.Buffer
+	err := wire.WriteVersion(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("writing version: %w", err)
+	}
+	err = wire.WriteBytes(&buf, ct.label)
+	if err != nil {
+		return nil, fmt.Errorf("writing label: %w", err)
+	}
+	err = wire.WriteLength(&buf, len(ct.t))
+	if err != nil {
+		return nil, fmt.Errorf("writing length: %w", err)
+	}
+	for i := range ct.t {
+		err = ct.encoder.writeEncryptedResponse(&buf, EncryptedResponse[G, P, E]{ct.t[i], ct.e[i]})
+		if err != nil {
+			return nil, fmt.Errorf("writing encrypted response %d: %w", i, err)
+		}
+		err = wire.WriteResponse[G, P](&buf, ct.encoder, ct.s[i])
+		if err != nil {
+			return nil, fmt.Errorf("writing response %d: %w", i, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary decodes a ciphertext. The receiver must have been created
+// by Decrypter.NewCiphertext.
+func (ct *Ciphertext[G, P, E]) UnmarshalBinary(data []byte) error {
+	if ct.encoder == nil {
+		return fmt.Errorf("missing encoder")
+	}
+
+	r := bytes.NewReader(data)
+	err := wire.ReadVersion(r)
+	if err != nil {
+		return err
+	}
+	label, err := wire.ReadBytes(r)
+	if err != nil {
+		return fmt.Errorf("reading label: %w", err)
+	}
+	u, err := wire.ReadLength(r)
+	if err != nil {
+		return fmt.Errorf("reading length: %w", err)
+	}
+	t := make([]sigma.Commitment[G, P], u)
+	s := make([]sigma.Response[G, P], u)
+	e := make([]probenc.Ciphertext[E], u)
+	for i := 0; i < u; i++ {
+		encResp, err := ct.encoder.readEncryptedResponse(r)
+		if err != nil {
+			return fmt.Errorf("reading encrypted response %d: %w", i, err)
+		}
+		t[i], e[i] = encResp.t, encResp.e
+		s[i], err = wire.ReadResponse[G, P](r, ct.encoder)
+		if err != nil {
+			return fmt.Errorf("reading response %d: %w", i, err)
+		}
+	}
+	err = wire.ReadEnd(r)
+	if err != nil {
+		return err
+	}
+	ct.label, ct.t, ct.s, ct.e = label, t, s, e
+	return nil
+}
+
+// NewCiphertext returns an empty ciphertext to unmarshal into.
+func (d *Decrypter[G, P, E]) NewCiphertext() *Ciphertext[G, P, E] {
+	return &Ciphertext[G, P, E]{
+		encoder: newEncoder[G, P, E](d.encoder),
+	}
+}
+
+// NewCiphertext returns an empty ciphertext to unmarshal into.
+func (d *ThresholdDecrypter[G, P, E]) NewCiphertext() *Ciphertext[G, P, E] {
+	return &Ciphertext[G, P, E]{
+		encoder: newEncoder[G, P, E](d.encoder),
+	}
+}
+
+func (share DecryptionShare[E]) MarshalBinary() ([]byte, error) {
+	return marshalSlices(share.shares)
+}
+
+func (share *DecryptionShare[E]) UnmarshalBinary(data []byte) error {
+	shares, err := unmarshalSlices[probenc.DecryptionShare[E]](data)
+	if err != nil {
+		return err
+	}
+	share.shares = shares
+	return nil
+}
+
+func (proof DecryptionProof[E]) MarshalBinary() ([]byte, error) {
+	return marshalSlices(proof.proofs)
+}
+
+func (proof *DecryptionProof[E]) UnmarshalBinary(data []byte) error {
+	proofs, err := unmarshalSlices[probenc.DecryptionProof[E]](data)
+	if err != nil {
+		return err
+	}
+	proof.proofs = proofs
+	return nil
+}
+
+// marshalSlices encodes a list of byte slices.
+func marshalSlices[T ~[]byte](ss []T) ([]byte, error) {
+	var buf bytes.Buffer
+	err := wire.WriteVersion(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("writing version: %w", err)
+	}
+	err = wire.WriteLength(&buf, len(ss))
+	if err != nil {
+		return nil, fmt.Errorf("writing length: %w", err)
+	}
+	for i, s := range ss {
+		err = wire.WriteBytes(&buf, s)
+		if err != nil {
+			return nil, fmt.Errorf("writing entry %d: %w", i, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func unmarshalSlices[T ~[]byte](data []byte) ([]T, error) {
+	r := bytes.NewReader(data)
+	err := wire.ReadVersion(r)
+	if err != nil {
+		return nil, err
+	}
+	l, err := wire.ReadLength(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading length: %w", err)
+	}
+	ss := make([]T, l)
+	for i := range ss {
+		ss[i], err = wire.ReadBytes(r)
+		if err != nil {
+			return nil, fmt.Errorf("reading entry %d: %w", i, err)
+		}
+	}
+	err = wire.ReadEnd(r)
+	if err != nil {
+		return nil, err
+	}
+	return ss, nil
+}
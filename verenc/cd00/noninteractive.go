@@ -0,0 +1,139 @@
+package cd00
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	sigma "github.com/matthiasgeihs/go-curve/sigma/binary"
+	"github.com/matthiasgeihs/go-curve/verenc"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/internal/wire"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
+)
+
+// challengeDST is the domain separation tag used for deriving challenges in
+// the non-interactive mode.
+var challengeDST = []byte("go-curve-verenc-cd00-v1")
+
+// Proof is a serialized non-interactive proof of correct encryption.
+type Proof = verenc.Proof
+
+// Prove computes a non-interactive proof that it encrypts the witness w for x.
+// The challenge is derived from a hash of the label, the word and the
+// encrypted responses, following the Fiat-Shamir transform. As the encrypted
+// responses are hashed directly, no commitment is needed.
+func (p Prover[G, P, E, C]) Prove(
+	x sigma.Word[G, P],
+	w sigma.Witness[G, P],
+	label []byte,
+) (Proof, error) {
+	if p.u == 0 || p.u > p.k {
+		return nil, fmt.Errorf("invalid number of opened rounds: %d", p.u)
+	}
+	decomms, encResps, rands, err := p.encryptResponses(x, w, label)
+	if err != nil {
+		return nil, err
+	}
+
+	ch, err := deriveChallenge(p.encoder, p.k, p.u, x, encResps, label)
+	if err != nil {
+		return nil, fmt.Errorf("deriving challenge: %w", err)
+	}
+
+	resp, err := p.Respond(Decommitment[G, P, E, C]{
+		x:       x,
+		w:       w,
+		label:   label,
+		decomms: decomms,
+		s:       encResps,
+		r:       rands,
+	}, ch)
+	if err != nil {
+		return nil, fmt.Errorf("computing response: %w", err)
+	}
+	proof, err := p.encoder.EncodeProof(resp.proofResponse)
+	if err != nil {
+		return nil, fmt.Errorf("encoding proof: %w", err)
+	}
+	return proof, nil
+}
+
+// VerifyProof verifies a non-interactive proof for x under the given label and
+// returns the ciphertext on success.
+func (v *Verifier[G, P, E, C]) VerifyProof(
+	x sigma.Word[G, P],
+	label []byte,
+	proof Proof,
+) (Ciphertext[G, P, E], error) {
+	resp, err := v.encoder.DecodeProof(proof)
+	if err != nil {
+		return Ciphertext[G, P, E]{}, fmt.Errorf("decoding proof: %w", err)
+	}
+
+	ch, err := deriveChallenge(v.encoder, v.k, v.u, x, resp.encResps, label)
+	if err != nil {
+		return Ciphertext[G, P, E]{}, fmt.Errorf("deriving challenge: %w", err)
+	}
+	return v.verifyResponses(x, label, ch, resp)
+}
+
+// deriveChallenge selects u of k indices using randomness derived from the
+// label, the word and the encrypted responses.
+func deriveChallenge[G curve.Curve, P sigma.Protocol, E probenc.Scheme](
+	e *encoder[G, P, E],
+	k, u uint,
+	x sigma.Word[G, P],
+	encResps []EncryptedResponse[G, P, E],
+	label []byte,
+) (Challenge, error) {
+	xBytes, err := e.EncodeWord(x)
+	if err != nil {
+		return nil, fmt.Errorf("encoding word: %w", err)
+	}
+	encRespsBytes, err := e.EncodeEncryptedResponses(encResps)
+	if err != nil {
+		return nil, fmt.Errorf("encoding encrypted responses: %w", err)
+	}
+
+	var buf bytes.Buffer
+	for _, b := range [][]byte{challengeDST, label, xBytes, encRespsBytes} {
+		err := wire.WriteBytes(&buf, b)
+		if err != nil {
+			return nil, fmt.Errorf("writing transcript: %w", err)
+		}
+	}
+	seed := sha256.Sum256(buf.Bytes())
+	return nChooseK(k, u, newHashReader(sha256.New, seed[:]))
+}
+
+// hashReader is a deterministic stream of bytes that outputs the blocks
+// H(seed || counter) for counter = 0, 1, ... as a 64-bit big-endian integer.
+type hashReader struct {
+	h       hash.Hash
+	seed    []byte
+	counter uint64
+	buf     []byte
+}
+
+func newHashReader(newHash func() hash.Hash, seed []byte) *hashReader {
+	return &hashReader{
+		h:    newHash(),
+		seed: seed,
+	}
+}
+
+func (r *hashReader) Read(p []byte) (int, error) {
+	for len(r.buf) < len(p) {
+		r.h.Reset()
+		r.h.Write(r.seed)
+		_ = binary.Write(r.h, binary.BigEndian, r.counter)
+		r.buf = r.h.Sum(r.buf)
+		r.counter++
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
@@ -0,0 +1,45 @@
+package cd00
+
+// Option configures a Prover or a Verifier.
+type Option func(*options)
+
+type options struct {
+	workers int
+	u       uint
+}
+
+func makeOptions(opts []Option) options {
+	o := options{
+		workers: 1,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithWorkers sets the number of goroutines that process the independent
+// rounds of the protocol. The default is 1. With more than one worker, the
+// sigma protocol and the encryption scheme must be safe for concurrent use.
+// Each round reads its randomness from its own stream, which is derived from
+// the randomness source of the prover, so that proofs do not depend on the
+// number of workers. Sigma provers that do not implement sigma.RandProver
+// read from their own randomness source instead, which must then be safe for
+// concurrent use as well.
+func WithWorkers(n int) Option {
+	return func(o *options) {
+		if n < 1 {
+			n = 1
+		}
+		o.workers = n
+	}
+}
+
+// WithOpenedRounds sets the number u of rounds that the challenge of a
+// non-interactive proof opens. It only affects Prover.Prove, which fails if u
+// is not set, and must match the u of the verifier.
+func WithOpenedRounds(u uint) Option {
+	return func(o *options) {
+		o.u = u
+	}
+}
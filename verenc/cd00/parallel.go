@@ -0,0 +1,92 @@
+package cd00
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// parallel calls f(i) for i = 0, ..., n-1 using the given number of
+// goroutines. If calls fail, it returns the error of the call with the lowest
+// index, so that the result does not depend on scheduling.
+func parallel(workers int, n uint, f func(i uint) error) error {
+	if workers > int(n) {
+		workers = int(n)
+	}
+
+	errs := make([]error, n)
+	var next atomic.Uint64
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for j := 0; j < workers; j++ {
+		go func() {
+			defer wg.Done()
+			for {
+				i := uint(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+				errs[i] = f(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// roundReaders derives an independent randomness source for each of n rounds
+// from a 256-bit seed read from rnd. Round i reads the AES-256-CTR keystream
+// under the seed with the initial counter block i || 0, so the streams of
+// different rounds are disjoint segments of one keystream as long as a round
+// reads less than 2^68 bytes. This makes the randomness of a round independent
+// of the order in which the rounds are processed.
+//
+// The streams only protect the secrecy of the witness. The soundness of the
+// proof does not depend on the randomness of the prover. The witness stays
+// hidden as long as the keystream is indistinguishable from random, which
+// holds if AES is a pseudorandom permutation and the seed is secret.
+type roundReaders struct {
+	block cipher.Block
+}
+
+func newRoundReaders(rnd io.Reader) (roundReaders, error) {
+	var seed [32]byte
+	_, err := io.ReadFull(rnd, seed[:])
+	if err != nil {
+		return roundReaders{}, fmt.Errorf("reading seed: %w", err)
+	}
+	block, err := aes.NewCipher(seed[:])
+	if err != nil {
+		return roundReaders{}, err
+	}
+	return roundReaders{block}, nil
+}
+
+func (r roundReaders) reader(i uint) io.Reader {
+	iv := make([]byte, aes.BlockSize)
+	binary.BigEndian.PutUint64(iv, uint64(i))
+	return cipher.StreamReader{
+		S: cipher.NewCTR(r.block, iv),
+		R: zeroReader{},
+	}
+}
+
+// zeroReader is an endless stream of zero bytes.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
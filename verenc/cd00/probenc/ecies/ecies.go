@@ -0,0 +1,212 @@
+// ecies implements hybrid encryption from an ephemeral elliptic curve
+// Diffie-Hellman key encapsulation and AES-256-GCM, similar to the ECIES scheme
+// of SEC 1. All randomness is drawn from the given randomness source, which
+// makes encryption replayable as required by probenc.Encrypt.
+//
+// As in the TDH2 scheme of Shoup and Gennaro, a ciphertext with ephemeral key
+// R = r*G also contains U = r*H for a second generator H and a Fiat-Shamir
+// proof that log_G R = log_H U, which is bound to the label and the AEAD
+// ciphertext. The shared point r*pk does not depend on the label, so the key
+// holder and the trustees of a threshold instance check the proof before
+// using their key on R. This prevents anyone from moving an ephemeral key to a
+// ciphertext under a different label and having it decrypted there.
+package ecies
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/internal/dem"
+	"github.com/matthiasgeihs/go-curve/sigma/dleq"
+	"github.com/matthiasgeihs/go-curve/sigma/nizk"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
+)
+
+type Scheme[C curve.Curve] struct{}
+
+// kdfDST is the domain separation tag of the key derivation.
+var kdfDST = []byte("go-curve-probenc-ecies-v1")
+
+// validityDST is the domain separation tag of the second generator and the
+// validity proofs.
+var validityDST = []byte("go-curve-probenc-ecies-validity-v1")
+
+type SecretKey[C curve.Curve] curve.Scalar[C]
+type PubKey[C curve.Curve] curve.Point[C]
+
+func NewInstance[C curve.Curve](gen curve.Generator[C], rnd io.Reader) (
+	Encrypter[C],
+	Decrypter[C],
+	error,
+) {
+	sk, err := gen.RandomScalar(rnd)
+	if err != nil {
+		return Encrypter[C]{}, Decrypter[C]{}, fmt.Errorf("generating secret key: %w", err)
+	}
+	pk := gen.MulBase(sk)
+	return NewEncrypter[C](gen, pk), NewDecrypter[C](gen, sk), nil
+}
+
+type Encrypter[C curve.Curve] struct {
+	gen curve.Generator[C]
+	pk  PubKey[C]
+}
+
+func NewEncrypter[C curve.Curve](gen curve.Generator[C], pk PubKey[C]) Encrypter[C] {
+	return Encrypter[C]{
+		gen: gen,
+		pk:  pk,
+	}
+}
+
+// Encrypt encrypts data to the public key. The ciphertext consists of the
+// compressed ephemeral public key R, the compressed point U, the
+// length-prefixed validity proof and the AEAD ciphertext, which authenticates
+// the label as additional data.
+func (e Encrypter[C]) Encrypt(rnd io.Reader, data []byte, label []byte) (probenc.Ciphertext[Scheme[C]], error) {
+	r, err := e.gen.RandomScalar(rnd)
+	if err != nil {
+		return nil, fmt.Errorf("generating ephemeral key: %w", err)
+	}
+	rPub := e.gen.MulBase(r)
+	u := curve.MulSecret[C](secondGenerator(e.gen), r)
+	shared := curve.MulSecret[C](e.pk, r)
+
+	aead, err := newAEAD(rPub.CompressedBytes(), shared)
+	if err != nil {
+		return nil, err
+	}
+	sealed := aead.Seal(nil, data, label)
+
+	x := dleq.MakeWord[C](e.gen.Generator(), rPub, secondGenerator(e.gen), u)
+	proof, err := newValidityNIZK(e.gen, rnd, label, sealed).Prove(x, dleq.Witness[C](r))
+	if err != nil {
+		return nil, fmt.Errorf("proving validity: %w", err)
+	}
+
+	ct := append(rPub.CompressedBytes(), u.CompressedBytes()...)
+	ct = binary.BigEndian.AppendUint16(ct, uint16(len(proof)))
+	ct = append(ct, proof...)
+	return append(ct, sealed...), nil
+}
+
+type Decrypter[C curve.Curve] struct {
+	gen curve.Generator[C]
+	sk  SecretKey[C]
+}
+
+func NewDecrypter[C curve.Curve](gen curve.Generator[C], sk SecretKey[C]) Decrypter[C] {
+	return Decrypter[C]{
+		gen: gen,
+		sk:  sk,
+	}
+}
+
+// Decrypt checks the validity of the ciphertext under the label and decrypts
+// it.
+func (d Decrypter[C]) Decrypt(ct probenc.Ciphertext[Scheme[C]], label []byte) ([]byte, error) {
+	c, err := parseCiphertext(d.gen, ct, label)
+	if err != nil {
+		return nil, err
+	}
+	shared := curve.MulSecret[C](c.rPub, d.sk)
+	return open(c.rPub, shared, c.sealed, label)
+}
+
+// ciphertext is a decoded ECIES ciphertext.
+type ciphertext[C curve.Curve] struct {
+	rPub, u curve.Point[C]
+	proof   nizk.Proof
+	sealed  []byte
+}
+
+// parseCiphertext decodes the ciphertext and checks its validity proof under
+// the label.
+func parseCiphertext[C curve.Curve](
+	gen curve.Generator[C],
+	ct probenc.Ciphertext[Scheme[C]],
+	label []byte,
+) (ciphertext[C], error) {
+	l := len(gen.Generator().CompressedBytes())
+	if len(ct) < 2*l+2 {
+		return ciphertext[C]{}, fmt.Errorf("ciphertext too short")
+	}
+	rPub, err := gen.DecodePoint(ct[:l])
+	if err != nil {
+		return ciphertext[C]{}, fmt.Errorf("decoding ephemeral key: %w", err)
+	}
+	u, err := gen.DecodePoint(ct[l : 2*l])
+	if err != nil {
+		return ciphertext[C]{}, fmt.Errorf("decoding validity point: %w", err)
+	}
+	rest := ct[2*l:]
+	pl := int(binary.BigEndian.Uint16(rest))
+	rest = rest[2:]
+	if len(rest) < pl {
+		return ciphertext[C]{}, fmt.Errorf("ciphertext too short")
+	}
+	c := ciphertext[C]{
+		rPub:   rPub,
+		u:      u,
+		proof:  nizk.Proof(rest[:pl]),
+		sealed: rest[pl:],
+	}
+	if !c.isValid(gen, label) {
+		return ciphertext[C]{}, fmt.Errorf("invalid ciphertext")
+	}
+	return c, nil
+}
+
+// isValid checks the proof that R and U have the same discrete logarithm with
+// respect to G and H under the label.
+func (c ciphertext[C]) isValid(gen curve.Generator[C], label []byte) bool {
+	x := dleq.MakeWord[C](gen.Generator(), c.rPub, secondGenerator(gen), c.u)
+	return newValidityNIZK(gen, nil, label, c.sealed).Verify(x, c.proof)
+}
+
+// secondGenerator returns the generator H, whose discrete logarithm with
+// respect to G is unknown.
+func secondGenerator[C curve.Curve](gen curve.Generator[C]) curve.Point[C] {
+	return gen.HashToPoint(validityDST, nil)
+}
+
+// newValidityNIZK returns the NIZK for validity proofs. Its label binds the
+// proofs to the label of the ciphertext and to the AEAD ciphertext.
+func newValidityNIZK[C curve.Curve](
+	gen curve.Generator[C],
+	rnd io.Reader,
+	label []byte,
+	sealed []byte,
+) nizk.NIZK[C, dleq.Protocol] {
+	l := binary.BigEndian.AppendUint64(append([]byte(nil), validityDST...), uint64(len(label)))
+	l = append(l, label...)
+	l = append(l, sealed...)
+	return nizk.New[C, dleq.Protocol](
+		dleq.NewProver[C](gen, rnd),
+		dleq.NewVerifier[C](gen, rnd),
+		dleq.NewEncoder[C](gen),
+		l,
+	)
+}
+
+// open decrypts the AEAD ciphertext using the key derived from the ephemeral
+// public key and the shared point.
+func open[C curve.Curve](rPub, shared curve.Point[C], sealed, label []byte) ([]byte, error) {
+	aead, err := newAEAD(rPub.CompressedBytes(), shared)
+	if err != nil {
+		return nil, err
+	}
+	data, err := aead.Open(sealed, label)
+	if err != nil {
+		return nil, fmt.Errorf("opening ciphertext: %w", err)
+	}
+	return data, nil
+}
+
+// newAEAD derives the symmetric key from the ephemeral public key and the
+// shared point.
+func newAEAD[C curve.Curve](rPub []byte, shared curve.Point[C]) (dem.AEAD, error) {
+	return dem.NewAEAD(kdfDST, rPub, shared.CompressedBytes())
+}
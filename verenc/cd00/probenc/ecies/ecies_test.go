@@ -0,0 +1,119 @@
+package ecies_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve/ristretto255"
+	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc/ecies"
+)
+
+var _ probenc.Encrypter[ecies.Scheme[secp256k1.Curve]] = ecies.Encrypter[secp256k1.Curve]{}
+var _ probenc.Decrypter[ecies.Scheme[secp256k1.Curve]] = ecies.Decrypter[secp256k1.Curve]{}
+var _ probenc.DecryptionProver[ecies.Scheme[secp256k1.Curve]] = ecies.Decrypter[secp256k1.Curve]{}
+var _ probenc.DecryptionVerifier[ecies.Scheme[secp256k1.Curve]] = ecies.Encrypter[secp256k1.Curve]{}
+
+func TestECIES_secp256k1(t *testing.T) {
+	testECIES[secp256k1.Curve](t, secp256k1.NewGenerator())
+}
+
+func TestECIES_edwards25519(t *testing.T) {
+	testECIES[edwards25519.Curve](t, edwards25519.NewGenerator())
+}
+
+func TestECIES_ristretto255(t *testing.T) {
+	testECIES[ristretto255.Curve](t, ristretto255.NewGenerator())
+}
+
+func testECIES[C curve.Curve](t *testing.T, g curve.Generator[C]) {
+	rnd := rand.Reader
+	enc, dec, err := ecies.NewInstance(g, rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("response")
+	label := []byte("label")
+	ct, r, err := probenc.Encrypt[ecies.Scheme[C]](rnd, data, label, enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Replaying the randomness must reproduce the ciphertext.
+	ctReplay, err := enc.Encrypt(bytes.NewReader(r), data, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(ct, ctReplay) {
+		t.Error("replayed encryption should equal ciphertext")
+	}
+
+	dataDec, err := dec.Decrypt(ct, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, dataDec) {
+		t.Error("decryption should equal encrypted data")
+	}
+
+	if _, err := dec.Decrypt(ct, []byte("other")); err == nil {
+		t.Error("ciphertext should not decrypt under a different label")
+	}
+	// Flip bytes of R, U, the validity proof and the AEAD ciphertext.
+	l := len(g.Generator().CompressedBytes())
+	for _, i := range []int{0, l, 2*l + 3, len(ct) - 1} {
+		tampered := append(probenc.Ciphertext[ecies.Scheme[C]](nil), ct...)
+		tampered[i] ^= 1
+		if _, err := dec.Decrypt(tampered, label); err == nil {
+			t.Errorf("ciphertext with byte %d flipped should not decrypt", i)
+		}
+	}
+	if _, err := dec.Decrypt(ct[:1], label); err == nil {
+		t.Error("truncated ciphertext should not decrypt")
+	}
+
+	// Decryption proofs.
+	proof, err := dec.ProveDecryption(rnd, ct, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dataDec, ok, err := enc.VerifyDecryption(ct, label, proof); err != nil || !ok || !bytes.Equal(data, dataDec) {
+		t.Error("decryption proof should verify and yield the encrypted data")
+	}
+	if _, _, err := enc.VerifyDecryption(ct, []byte("other"), proof); err == nil {
+		t.Error("decryption proof should not verify under a different label")
+	}
+
+	// Under a different label, the shared point is not revealed.
+	proof, err = dec.ProveDecryption(rnd, ct, []byte("other"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proof) != 0 {
+		t.Error("decryption proof should be empty under a different label")
+	}
+	if _, ok, err := enc.VerifyDecryption(ct, []byte("other"), proof); err != nil || ok {
+		t.Error("decryption proof should show failure under a different label")
+	}
+	proof, err = dec.ProveDecryption(rnd, ct, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tampered := append(probenc.DecryptionProof[ecies.Scheme[C]](nil), proof...)
+	tampered[1] ^= 1
+	if _, _, err := enc.VerifyDecryption(ct, label, tampered); err == nil {
+		t.Error("tampered decryption proof should not verify")
+	}
+	proof, err = dec.ProveDecryption(rnd, ct[:1], label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, err := enc.VerifyDecryption(ct[:1], label, proof); err != nil || ok {
+		t.Error("decryption proof should show failure for a malformed ciphertext")
+	}
+}
@@ -0,0 +1,51 @@
+package ecies
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
+)
+
+// ProveDecryption reveals the shared point of the ciphertext together with a
+// proof of its correctness, from which anyone can derive the symmetric key.
+// As the shared point does not depend on the label, it is only revealed for
+// ciphertexts whose validity proof holds under the label. Other ciphertexts
+// fail publicly and get an empty proof.
+func (d Decrypter[C]) ProveDecryption(
+	rnd io.Reader,
+	ct probenc.Ciphertext[Scheme[C]],
+	label []byte,
+) (probenc.DecryptionProof[Scheme[C]], error) {
+	c, err := parseCiphertext(d.gen, ct, label)
+	if err != nil {
+		return nil, nil
+	}
+	return proveShared[C](d.gen, rnd, d.sk, c.rPub)
+}
+
+// VerifyDecryption checks the revealed shared point against the public key
+// and decrypts the ciphertext with it. Malformed ciphertexts and ciphertexts
+// that are invalid under the label must come with an empty proof.
+func (e Encrypter[C]) VerifyDecryption(
+	ct probenc.Ciphertext[Scheme[C]],
+	label []byte,
+	proof probenc.DecryptionProof[Scheme[C]],
+) ([]byte, bool, error) {
+	c, err := parseCiphertext(e.gen, ct, label)
+	if err != nil {
+		if len(proof) != 0 {
+			return nil, false, fmt.Errorf("unexpected proof for invalid ciphertext")
+		}
+		return nil, false, nil
+	}
+	shared, ok := verifyShared[C](e.gen, e.pk, c.rPub, proof)
+	if !ok {
+		return nil, false, fmt.Errorf("invalid proof")
+	}
+	data, err := open(c.rPub, shared, c.sealed, label)
+	if err != nil {
+		return nil, false, nil
+	}
+	return data, true, nil
+}
@@ -0,0 +1,192 @@
+package ecies
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/elgamal/enc"
+	"github.com/matthiasgeihs/go-curve/internal/shamir"
+	"github.com/matthiasgeihs/go-curve/sigma/dleq"
+	"github.com/matthiasgeihs/go-curve/sigma/nizk"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
+)
+
+// shareDST is the label of the proofs of correct shared points.
+var shareDST = []byte("go-curve-probenc-ecies-share-v1")
+
+// NewTrustee returns the trustee holding the key share ks of a key generated
+// with the distributed key generation of package elgamal/enc, so that no party
+// ever holds the full secret key. Ciphertexts are ordinary ECIES ciphertexts
+// under the joint public key of the ThresholdKey, for which NewEncrypter
+// returns the encrypter.
+func NewTrustee[C curve.Curve](gen curve.Generator[C], ks enc.KeyShare[C], rnd io.Reader) Trustee[C] {
+	return Trustee[C]{
+		gen:   gen,
+		index: ks.Index(),
+		share: ks.SecretKey(),
+		rnd:   rnd,
+	}
+}
+
+// Trustee holds a share of the secret key of a threshold instance.
+type Trustee[C curve.Curve] struct {
+	gen   curve.Generator[C]
+	index int
+	share curve.Scalar[C]
+	rnd   io.Reader
+}
+
+// Index returns the evaluation point of the trustee's share, starting at 1.
+func (tr Trustee[C]) Index() int {
+	return tr.index
+}
+
+// PartialDecrypt checks the validity of the ciphertext under the label,
+// multiplies the ephemeral public key with the key share and proves that the
+// result has the same discrete logarithm with respect to the ephemeral key as
+// the trustee's public share with respect to the generator. Trustees must not
+// release shares for invalid ciphertexts, as the partial decryption does not
+// depend on the label.
+func (tr Trustee[C]) PartialDecrypt(ct probenc.Ciphertext[Scheme[C]], label []byte) (probenc.DecryptionShare[Scheme[C]], error) {
+	c, err := parseCiphertext(tr.gen, ct, label)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := proveShared(tr.gen, tr.rnd, tr.share, c.rPub)
+	if err != nil {
+		return nil, fmt.Errorf("proving partial decryption: %w", err)
+	}
+	share := binary.BigEndian.AppendUint32(nil, uint32(tr.index))
+	return append(share, proof...), nil
+}
+
+type Combiner[C curve.Curve] struct {
+	gen       curve.Generator[C]
+	t         int
+	pubShares []curve.Point[C]
+}
+
+// NewCombiner returns a combiner for t-out-of-n decryption, where pubShares
+// holds the public key shares of trustees 1 to n, such as the verification
+// keys of an elgamal/enc ThresholdKey.
+func NewCombiner[C curve.Curve](gen curve.Generator[C], t int, pubShares []curve.Point[C]) (Combiner[C], error) {
+	if t < 1 || t > len(pubShares) {
+		return Combiner[C]{}, fmt.Errorf("invalid threshold: %d of %d", t, len(pubShares))
+	}
+	return Combiner[C]{
+		gen:       gen,
+		t:         t,
+		pubShares: pubShares,
+	}, nil
+}
+
+// Combine checks the validity of the ciphertext under the label, verifies the
+// decryption shares, interpolates the shared point from the first t valid
+// shares of distinct trustees and decrypts the ciphertext.
+func (c Combiner[C]) Combine(
+	ct probenc.Ciphertext[Scheme[C]],
+	label []byte,
+	shares []probenc.DecryptionShare[Scheme[C]],
+) ([]byte, error) {
+	if c.t < 1 {
+		return nil, fmt.Errorf("invalid threshold: %d", c.t)
+	}
+	parsed, err := parseCiphertext(c.gen, ct, label)
+	if err != nil {
+		return nil, err
+	}
+	rPub := parsed.rPub
+
+	var indices []int
+	var points []curve.Point[C]
+	seen := make(map[int]bool)
+	for _, share := range shares {
+		if len(indices) == c.t {
+			break
+		}
+		i, d, ok := c.verifyShare(rPub, share)
+		if !ok || seen[i] {
+			continue
+		}
+		seen[i] = true
+		indices = append(indices, i)
+		points = append(points, d)
+	}
+	if len(indices) < c.t {
+		return nil, fmt.Errorf("not enough valid shares: %d of %d", len(indices), c.t)
+	}
+
+	shared := curve.MultiScalarMul(points, shamir.LagrangeCoefficients(c.gen, indices))
+	return open(rPub, shared, parsed.sealed, label)
+}
+
+// verifyShare decodes the share and checks its proof. It returns the index of
+// the trustee and the partial decryption.
+func (c Combiner[C]) verifyShare(
+	rPub curve.Point[C],
+	share probenc.DecryptionShare[Scheme[C]],
+) (int, curve.Point[C], bool) {
+	if len(share) < 4 {
+		return 0, nil, false
+	}
+	i := int(binary.BigEndian.Uint32(share))
+	if i < 1 || i > len(c.pubShares) {
+		return 0, nil, false
+	}
+	d, ok := verifyShared(c.gen, c.pubShares[i-1], rPub, share[4:])
+	return i, d, ok
+}
+
+// proveShared computes the shared point sk·R and proves that its discrete
+// logarithm with respect to R equals that of the public key sk·G. It returns
+// the length-prefixed shared point followed by the proof.
+func proveShared[C curve.Curve](
+	gen curve.Generator[C],
+	rnd io.Reader,
+	sk curve.Scalar[C],
+	rPub curve.Point[C],
+) ([]byte, error) {
+	shared := curve.MulSecret[C](rPub, sk)
+	x := dleq.MakeWord[C](gen.Generator(), gen.MulBase(sk), rPub, shared)
+	proof, err := newShareNIZK(gen, rnd).Prove(x, dleq.Witness[C](sk))
+	if err != nil {
+		return nil, err
+	}
+	b := shared.CompressedBytes()
+	data := append([]byte{byte(len(b))}, b...)
+	return append(data, proof...), nil
+}
+
+// verifyShared decodes and checks the output of proveShared for the public
+// key pk.
+func verifyShared[C curve.Curve](
+	gen curve.Generator[C],
+	pk curve.Point[C],
+	rPub curve.Point[C],
+	data []byte,
+) (curve.Point[C], bool) {
+	if len(data) == 0 || len(data) < 1+int(data[0]) {
+		return nil, false
+	}
+	l := int(data[0])
+	shared, err := gen.DecodePoint(data[1 : 1+l])
+	if err != nil {
+		return nil, false
+	}
+	x := dleq.MakeWord[C](gen.Generator(), pk, rPub, shared)
+	if !newShareNIZK(gen, nil).Verify(x, nizk.Proof(data[1+l:])) {
+		return nil, false
+	}
+	return shared, true
+}
+
+func newShareNIZK[C curve.Curve](gen curve.Generator[C], rnd io.Reader) nizk.NIZK[C, dleq.Protocol] {
+	return nizk.New[C, dleq.Protocol](
+		dleq.NewProver[C](gen, rnd),
+		dleq.NewVerifier[C](gen, rnd),
+		dleq.NewEncoder[C](gen),
+		shareDST,
+	)
+}
@@ -0,0 +1,159 @@
+package ecies_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
+	elgamal "github.com/matthiasgeihs/go-curve/elgamal/enc"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc/ecies"
+)
+
+var _ probenc.PartialDecrypter[ecies.Scheme[secp256k1.Curve]] = ecies.Trustee[secp256k1.Curve]{}
+var _ probenc.Combiner[ecies.Scheme[secp256k1.Curve]] = ecies.Combiner[secp256k1.Curve]{}
+
+func TestThreshold_secp256k1(t *testing.T) {
+	testThreshold[secp256k1.Curve](t, secp256k1.NewGenerator())
+}
+
+func TestThreshold_edwards25519(t *testing.T) {
+	testThreshold[edwards25519.Curve](t, edwards25519.NewGenerator())
+}
+
+func testThreshold[C curve.Curve](t *testing.T, g curve.Generator[C]) {
+	rnd := rand.Reader
+	enc, trustees, comb := newThresholdInstance(t, g, rnd, 2, 3)
+
+	data := []byte("response")
+	label := []byte("label")
+	ct, err := enc.Encrypt(rnd, data, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tr := range trustees {
+		if _, err := tr.PartialDecrypt(ct, []byte("other")); err == nil {
+			t.Error("trustee should refuse a ciphertext under a different label")
+		}
+	}
+
+	shares := make([]probenc.DecryptionShare[ecies.Scheme[C]], len(trustees))
+	for i, tr := range trustees {
+		shares[i], err = tr.PartialDecrypt(ct, label)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Any two shares suffice.
+	for _, subset := range [][]int{{0, 1}, {0, 2}, {2, 1}} {
+		var s []probenc.DecryptionShare[ecies.Scheme[C]]
+		for _, i := range subset {
+			s = append(s, shares[i])
+		}
+		dataDec, err := comb.Combine(ct, label, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, dataDec) {
+			t.Errorf("combined decryption should equal encrypted data for shares %v", subset)
+		}
+	}
+
+	if _, err := comb.Combine(ct, label, shares[:1]); err == nil {
+		t.Error("a single share should not suffice")
+	}
+	if _, err := comb.Combine(ct, label, []probenc.DecryptionShare[ecies.Scheme[C]]{shares[0], shares[0]}); err == nil {
+		t.Error("duplicate shares should not count twice")
+	}
+	if _, err := comb.Combine(ct, []byte("other"), shares); err == nil {
+		t.Error("ciphertext should not decrypt under a different label")
+	}
+
+	// A share with a manipulated partial decryption is ignored.
+	bad := append(probenc.DecryptionShare[ecies.Scheme[C]](nil), shares[0]...)
+	bad[len(bad)-1] ^= 1
+	if _, err := comb.Combine(ct, label, []probenc.DecryptionShare[ecies.Scheme[C]]{bad, shares[1]}); err == nil {
+		t.Error("share with invalid proof should be ignored")
+	}
+	dataDec, err := comb.Combine(ct, label, []probenc.DecryptionShare[ecies.Scheme[C]]{bad, shares[1], shares[2]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, dataDec) {
+		t.Error("combined decryption should equal encrypted data")
+	}
+
+	// Shares of a different ciphertext do not verify.
+	ct2, err := enc.Encrypt(rnd, data, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := comb.Combine(ct2, label, shares); err == nil {
+		t.Error("shares of another ciphertext should be rejected")
+	}
+}
+
+func TestNewCombiner_invalidThreshold(t *testing.T) {
+	g := secp256k1.NewGenerator()
+	pubShares := []curve.Point[secp256k1.Curve]{g.Generator(), g.Generator()}
+	for _, threshold := range []int{0, 3} {
+		if _, err := ecies.NewCombiner[secp256k1.Curve](g, threshold, pubShares); err == nil {
+			t.Errorf("threshold %d of %d should be rejected", threshold, len(pubShares))
+		}
+	}
+	if _, err := (ecies.Combiner[secp256k1.Curve]{}).Combine(nil, nil, nil); err == nil {
+		t.Error("zero combiner should fail")
+	}
+}
+
+// newThresholdInstance generates a t-out-of-n key with the distributed key
+// generation of elgamal/enc and returns the encrypter, trustees and combiner
+// for it.
+func newThresholdInstance[C curve.Curve](
+	t *testing.T,
+	g curve.Generator[C],
+	rnd io.Reader,
+	threshold, n int,
+) (ecies.Encrypter[C], []ecies.Trustee[C], ecies.Combiner[C]) {
+	parties := make([]elgamal.Cipher[C], n)
+	commitments := make([]elgamal.Commitments[C], n)
+	dealings := make([]elgamal.Dealing[C], n)
+	for i := range parties {
+		parties[i] = elgamal.NewCipher(g, rnd)
+		d, err := parties[i].Deal(i+1, threshold, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dealings[i] = d
+		commitments[i] = d.Commitments()
+	}
+
+	trustees := make([]ecies.Trustee[C], n)
+	for j := range trustees {
+		shares := make([]curve.Scalar[C], n)
+		for i, d := range dealings {
+			shares[i] = d.Share(j + 1)
+		}
+		ks, err := parties[j].NewKeyShare(j+1, commitments, shares)
+		if err != nil {
+			t.Fatal(err)
+		}
+		trustees[j] = ecies.NewTrustee(g, ks, rnd)
+	}
+
+	tk, err := parties[0].NewThresholdKey(n, commitments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	comb, err := ecies.NewCombiner(g, tk.Threshold(), tk.VerificationKeys())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ecies.NewEncrypter[C](g, ecies.PubKey[C](tk.PubKey())), trustees, comb
+}
@@ -8,27 +8,61 @@ import (
 
 type Scheme interface{}
 
+// Encrypter encrypts data under a label. The label is not encrypted, but
+// decryption under a different label fails.
 type Encrypter[S Scheme] interface {
-	Encrypt(io.Reader, []byte) (Ciphertext[S], error)
+	Encrypt(rnd io.Reader, data []byte, label []byte) (Ciphertext[S], error)
 }
 type Decrypter[S Scheme] interface {
-	Decrypt(Ciphertext[S]) ([]byte, error)
+	Decrypt(ct Ciphertext[S], label []byte) ([]byte, error)
 }
 type Ciphertext[S Scheme] []byte
 
+// PartialDecrypter holds the key share of a trustee of a threshold scheme. A
+// decryption share contains a proof of its correctness.
+type PartialDecrypter[S Scheme] interface {
+	PartialDecrypt(ct Ciphertext[S], label []byte) (DecryptionShare[S], error)
+}
+
+// Combiner recovers the plaintext from the decryption shares of at least the
+// threshold number of trustees. Shares with an invalid proof are ignored.
+type Combiner[S Scheme] interface {
+	Combine(ct Ciphertext[S], label []byte, shares []DecryptionShare[S]) ([]byte, error)
+}
+type DecryptionShare[S Scheme] []byte
+
+// DecryptionProver proves the outcome of decrypting a ciphertext under a
+// label, including that decryption fails. It must not reveal anything about
+// ciphertexts that are not bound to the label, for which it returns an empty
+// proof. Hence, only schemes that can check publicly whether a ciphertext is
+// bound to a label can implement it.
+type DecryptionProver[S Scheme] interface {
+	ProveDecryption(rnd io.Reader, ct Ciphertext[S], label []byte) (DecryptionProof[S], error)
+}
+
+// DecryptionVerifier checks decryption proofs using public information only.
+// It returns the decrypted data, or ok = false if the proof shows that
+// decryption fails. An empty proof shows failure for ciphertexts that are not
+// bound to the label.
+type DecryptionVerifier[S Scheme] interface {
+	VerifyDecryption(ct Ciphertext[S], label []byte, proof DecryptionProof[S]) (data []byte, ok bool, err error)
+}
+type DecryptionProof[S Scheme] []byte
+
 type RandomBytes []byte
 
-// Encrypt encrypts `data` using the probabilistic encryption algorithm `enc`
-// and using `rnd` as source of randomness. It returns the ciphertext and the
-// bytes consumed by `rnd`.
+// Encrypt encrypts `data` under `label` using the probabilistic encryption
+// algorithm `enc` and using `rnd` as source of randomness. It returns the
+// ciphertext and the bytes consumed by `rnd`.
 func Encrypt[E Scheme](
 	rnd io.Reader,
 	data []byte,
+	label []byte,
 	enc Encrypter[E],
 ) (Ciphertext[E], RandomBytes, error) {
 	var buf bytes.Buffer
 	rndExt := io.TeeReader(rnd, &buf)
-	ct, err := enc.Encrypt(rndExt, data)
+	ct, err := enc.Encrypt(rndExt, data, label)
 	if err != nil {
 		return nil, nil, fmt.Errorf("encrypting data: %w", err)
 	}
@@ -0,0 +1,125 @@
+// paillier adapts the Paillier cryptosystem to probenc. Data is encrypted as
+// a single plaintext and must therefore be shorter than the modulus. The
+// nonce is drawn from the given randomness source, which makes encryption
+// replayable as required by probenc.Encrypt.
+//
+// Paillier ciphertexts are malleable, so the label cannot be bound to the
+// ciphertext from the outside. Instead, the plaintext consists of a random
+// seed, a tag and the data, where the tag is a hash of the label, the seed and
+// the data. Decryption checks the tag. Moving a ciphertext to a different
+// label or shifting its plaintext requires a matching tag, which cannot be
+// computed without knowing the seed.
+//
+// Whether a ciphertext is bound to a label can only be checked with the
+// secret key. The adapter therefore does not implement
+// probenc.DecryptionProver: a decrypter cannot prove decryption failure
+// without revealing the plaintexts of ciphertexts moved from a different
+// label. A cd00 decrypter using Paillier cannot produce decryption proofs, so
+// its decryptions cannot be audited.
+package paillier
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/paillier"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
+)
+
+type Scheme struct{}
+
+// marker is prepended to the plaintext so that leading zero bytes survive the
+// conversion to an integer.
+const marker = 0x01
+
+// seedSize is the length of the random seed of the plaintext.
+const seedSize = 32
+
+// tagDST is the domain separation tag of the tag computation.
+var tagDST = []byte("go-curve-probenc-paillier-tag-v1")
+
+func NewInstance(rnd io.Reader, bits int) (
+	Encrypter,
+	Decrypter,
+	error,
+) {
+	sk, err := paillier.GenerateKey(rnd, bits)
+	if err != nil {
+		return Encrypter{}, Decrypter{}, fmt.Errorf("generating secret key: %w", err)
+	}
+	return NewEncrypter(sk.Public()), NewDecrypter(sk), nil
+}
+
+type Encrypter struct {
+	pk *paillier.PublicKey
+}
+
+func NewEncrypter(pk *paillier.PublicKey) Encrypter {
+	return Encrypter{
+		pk: pk,
+	}
+}
+
+func (e Encrypter) Encrypt(rnd io.Reader, data []byte, label []byte) (probenc.Ciphertext[Scheme], error) {
+	seed := make([]byte, seedSize)
+	_, err := io.ReadFull(rnd, seed)
+	if err != nil {
+		return nil, fmt.Errorf("reading seed: %w", err)
+	}
+	pt := append([]byte{marker}, seed...)
+	pt = append(pt, computeTag(label, seed, data)...)
+	m := new(big.Int).SetBytes(append(pt, data...))
+	if m.Cmp(e.pk.N()) >= 0 {
+		return nil, fmt.Errorf("data too long: %d bytes", len(data))
+	}
+	ct, _, err := e.pk.Encrypt(rnd, m)
+	if err != nil {
+		return nil, fmt.Errorf("encrypting data: %w", err)
+	}
+	return e.pk.EncodeCiphertext(ct), nil
+}
+
+type Decrypter struct {
+	sk *paillier.SecretKey
+}
+
+func NewDecrypter(sk *paillier.SecretKey) Decrypter {
+	return Decrypter{
+		sk: sk,
+	}
+}
+
+func (d Decrypter) Decrypt(ct probenc.Ciphertext[Scheme], label []byte) ([]byte, error) {
+	c, err := d.sk.DecodeCiphertext(ct)
+	if err != nil {
+		return nil, fmt.Errorf("decoding ciphertext: %w", err)
+	}
+	m, err := d.sk.Decrypt(c)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting ciphertext: %w", err)
+	}
+	b := m.Bytes()
+	if len(b) < 1+seedSize+sha256.Size || b[0] != marker {
+		return nil, fmt.Errorf("invalid plaintext encoding")
+	}
+	seed, tag, data := b[1:1+seedSize], b[1+seedSize:1+seedSize+sha256.Size], b[1+seedSize+sha256.Size:]
+	if !hmac.Equal(tag, computeTag(label, seed, data)) {
+		return nil, fmt.Errorf("label mismatch or invalid ciphertext")
+	}
+	return data, nil
+}
+
+// computeTag hashes the label, the seed and the data.
+func computeTag(label, seed, data []byte) []byte {
+	h := sha256.New()
+	h.Write(tagDST)
+	_ = binary.Write(h, binary.BigEndian, uint64(len(label)))
+	h.Write(label)
+	h.Write(seed)
+	h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,88 @@
+package paillier_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	pl "github.com/matthiasgeihs/go-curve/paillier"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc/paillier"
+)
+
+var _ probenc.Encrypter[paillier.Scheme] = paillier.Encrypter{}
+var _ probenc.Decrypter[paillier.Scheme] = paillier.Decrypter{}
+
+func TestPaillier(t *testing.T) {
+	rnd := rand.Reader
+	enc, dec, err := paillier.NewInstance(rnd, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	label := []byte("label")
+	for _, data := range [][]byte{nil, {0, 0, 1}, []byte("response")} {
+		ct, r, err := probenc.Encrypt[paillier.Scheme](rnd, data, label, enc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Replaying the randomness must reproduce the ciphertext.
+		ctReplay, err := enc.Encrypt(bytes.NewReader(r), data, label)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(ct, ctReplay) {
+			t.Error("replayed encryption should equal ciphertext")
+		}
+
+		dataDec, err := dec.Decrypt(ct, label)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, dataDec) {
+			t.Errorf("decryption should equal encrypted data %x, got %x", data, dataDec)
+		}
+	}
+
+	if _, err := enc.Encrypt(rnd, make([]byte, 128), label); err == nil {
+		t.Error("data exceeding the modulus should be rejected")
+	}
+	ct, err := enc.Encrypt(rnd, []byte("response"), label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dec.Decrypt(ct, []byte("other")); err == nil {
+		t.Error("ciphertext should not decrypt under a different label")
+	}
+	if _, err := dec.Decrypt(ct[1:], label); err == nil {
+		t.Error("truncated ciphertext should not decrypt")
+	}
+}
+
+func TestPaillier_malleability(t *testing.T) {
+	rnd := rand.Reader
+	sk, err := pl.GenerateKey(rnd, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := sk.Public()
+	enc, dec := paillier.NewEncrypter(pk), paillier.NewDecrypter(sk)
+
+	label := []byte("label")
+	ct, err := enc.Encrypt(rnd, []byte("response"), label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := pk.DecodeCiphertext(ct)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Shifting the plaintext must be detected.
+	shifted := pk.EncodeCiphertext(pk.AddPlain(c, big.NewInt(1)))
+	if _, err := dec.Decrypt(shifted, label); err == nil {
+		t.Error("ciphertext with shifted plaintext should not decrypt")
+	}
+}
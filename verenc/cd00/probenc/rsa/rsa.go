@@ -15,7 +15,6 @@ type Scheme struct{}
 var newHasher = func() hash.Hash {
 	return sha256.New()
 }
-var label []byte = nil
 
 func NewInstace(rnd io.Reader, l int) (
 	probenc.Encrypter[Scheme],
@@ -42,7 +41,8 @@ type Encrypter struct {
 	pk *rsa.PublicKey
 }
 
-func (e Encrypter) Encrypt(rnd io.Reader, data []byte) (probenc.Ciphertext[Scheme], error) {
+// Encrypt encrypts data using RSA-OAEP with the given label as OAEP label.
+func (e Encrypter) Encrypt(rnd io.Reader, data []byte, label []byte) (probenc.Ciphertext[Scheme], error) {
 	ct, err := rsa.EncryptOAEP(newHasher(), rnd, e.pk, data, label)
 	if err != nil {
 		return nil, err
@@ -55,6 +55,6 @@ type Decrypter struct {
 	rnd io.Reader
 }
 
-func (d Decrypter) Decrypt(ct probenc.Ciphertext[Scheme]) ([]byte, error) {
+func (d Decrypter) Decrypt(ct probenc.Ciphertext[Scheme], label []byte) ([]byte, error) {
 	return rsa.DecryptOAEP(newHasher(), d.rnd, d.sk, ct, label)
 }
@@ -3,24 +3,96 @@ package cd00_test
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding"
+	"encoding/binary"
+	"errors"
 	"io"
+	mrand "math/rand"
+	"runtime"
 	"testing"
 
 	"github.com/matthiasgeihs/go-curve/commit"
 	"github.com/matthiasgeihs/go-curve/commit/sha256"
 	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/edwards25519"
 	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
+	elgamal "github.com/matthiasgeihs/go-curve/elgamal/enc"
 	sigma "github.com/matthiasgeihs/go-curve/sigma/binary"
 	dlog "github.com/matthiasgeihs/go-curve/sigma/dlog/binary"
+	"github.com/matthiasgeihs/go-curve/verenc"
 	"github.com/matthiasgeihs/go-curve/verenc/cd00"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/internal/wiretest"
 	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc/ecies"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc/paillier"
 	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc/rsa"
 )
 
+var _ verenc.Prover[
+	sigma.Word[secp256k1.Curve, dlog.Protocol],
+	sigma.Witness[secp256k1.Curve, dlog.Protocol],
+] = cd00.Prover[secp256k1.Curve, dlog.Protocol, rsa.Scheme, sha256.Scheme]{}
+var _ verenc.Verifier[
+	sigma.Word[secp256k1.Curve, dlog.Protocol],
+	cd00.Ciphertext[secp256k1.Curve, dlog.Protocol, rsa.Scheme],
+] = &cd00.Verifier[secp256k1.Curve, dlog.Protocol, rsa.Scheme, sha256.Scheme]{}
+
 const K = 712
 const U = 20
 
 func TestProtocol_secp256k1(t *testing.T) {
+	testProtocol_secp256k1(t)
+}
+
+func TestProtocol_parallel(t *testing.T) {
+	testProtocol_secp256k1(t, cd00.WithWorkers(4))
+}
+
+// TestProtocol_deterministic checks that proofs depend only on the randomness
+// source and not on the number of workers.
+func TestProtocol_deterministic(t *testing.T) {
+	type G = secp256k1.Curve
+	type P = dlog.Protocol
+	type E = ecies.Scheme[edwards25519.Curve]
+	type C = sha256.Scheme
+	g := secp256k1.NewGenerator()
+	encrypter, _, err := ecies.NewInstance[edwards25519.Curve](edwards25519.NewGenerator(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := g.RandomScalar(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := g.MulBase(w)
+
+	prove := func(workers int) cd00.Proof {
+		// All parties read from the same source with a fixed seed, which is
+		// not safe for concurrent use.
+		rnd := yieldingReader{mrand.New(mrand.NewSource(1))}
+		p := cd00.NewProver[G, P, E, C](
+			K,
+			dlog.NewProver[G](g, rnd),
+			dlog.NewVerifier[G](g, rnd),
+			dlog.NewEncoder[G](g),
+			encrypter,
+			sha256.NewCommitter(rnd),
+			rnd,
+			cd00.WithWorkers(workers),
+			cd00.WithOpenedRounds(U),
+		)
+		proof, err := p.Prove(x, w, []byte("label"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return proof
+	}
+	if !bytes.Equal(prove(1), prove(4)) {
+		t.Error("proofs with 1 and 4 workers should be equal")
+	}
+}
+
+func testProtocol_secp256k1(t *testing.T, opts ...cd00.Option) {
 	type G = secp256k1.Curve
 	type P = dlog.Protocol
 	type E = rsa.Scheme
@@ -37,9 +109,339 @@ func TestProtocol_secp256k1(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
+	setupAndRun[G, P, E, C](t, rnd, g, commC, commV, p, v, ext, encoder, encrypter, decrypter, opts...)
+}
+
+func TestProtocol_ecies(t *testing.T) {
+	type G = secp256k1.Curve
+	type P = dlog.Protocol
+	type E = ecies.Scheme[edwards25519.Curve]
+	type C = sha256.Scheme
+	rnd := rand.Reader
+	g := secp256k1.NewGenerator()
+	p := dlog.NewProver[G](g, rnd)
+	v := dlog.NewVerifier[G](g, rnd)
+	commC := sha256.NewCommitter(rnd)
+	commV := sha256.NewVerifier()
+	ext := dlog.NewExtractor[G](g)
+	encoder := dlog.NewEncoder[G](g)
+	encrypter, decrypter, err := ecies.NewInstance[edwards25519.Curve](edwards25519.NewGenerator(), rnd)
+	if err != nil {
+		panic(err)
+	}
 	setupAndRun[G, P, E, C](t, rnd, g, commC, commV, p, v, ext, encoder, encrypter, decrypter)
 }
 
+func TestProtocol_paillier(t *testing.T) {
+	type G = secp256k1.Curve
+	type P = dlog.Protocol
+	type E = paillier.Scheme
+	type C = sha256.Scheme
+	rnd := rand.Reader
+	g := secp256k1.NewGenerator()
+	p := dlog.NewProver[G](g, rnd)
+	v := dlog.NewVerifier[G](g, rnd)
+	commC := sha256.NewCommitter(rnd)
+	commV := sha256.NewVerifier()
+	ext := dlog.NewExtractor[G](g)
+	encoder := dlog.NewEncoder[G](g)
+	encrypter, decrypter, err := paillier.NewInstance(rnd, 1024)
+	if err != nil {
+		panic(err)
+	}
+	setupAndRun[G, P, E, C](t, rnd, g, commC, commV, p, v, ext, encoder, encrypter, decrypter, cd00.WithWorkers(4))
+}
+
+func TestProtocol_threshold(t *testing.T) {
+	type G = secp256k1.Curve
+	type P = dlog.Protocol
+	type E = ecies.Scheme[edwards25519.Curve]
+	type C = sha256.Scheme
+	rnd := rand.Reader
+	g := secp256k1.NewGenerator()
+	p := dlog.NewProver[G](g, rnd)
+	v := dlog.NewVerifier[G](g, rnd)
+	ext := dlog.NewExtractor[G](g)
+	encoder := dlog.NewEncoder[G](g)
+	encrypter, trustees, combiner := newThresholdInstance[edwards25519.Curve](t, edwards25519.NewGenerator(), rnd, 2, 3)
+	prover := cd00.NewProver[G, P, E, C](K, p, v, encoder, encrypter, sha256.NewCommitter(rnd), rnd, cd00.WithOpenedRounds(U))
+	verifier := cd00.NewVerifier[G, P, E, C](rnd, K, U, sha256.NewVerifier(), v, encoder, encrypter)
+	d := cd00.NewThresholdDecrypter[G, P, E](v, ext, encoder, combiner)
+
+	w, err := g.RandomScalar(rnd)
+	if err != nil {
+		panic(err)
+	}
+	x := g.Generator().Mul(w)
+	label := []byte("escrow")
+	proof, err := prover.Prove(x, w, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct, err := verifier.VerifyProof(x, label, proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each trustee receives the ciphertext and returns its share over the wire.
+	shares := make([]cd00.DecryptionShare[E], len(trustees))
+	for i, tr := range trustees {
+		ctRecv := d.NewCiphertext()
+		wiretest.Transmit(t, ct, ctRecv)
+		if _, err := cd00.PartialDecrypt[G, P, E](*ctRecv, []byte("other"), tr); err == nil {
+			t.Error("trustee should refuse decryption under a different label")
+		}
+		share, err := cd00.PartialDecrypt[G, P, E](*ctRecv, label, tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wiretest.Transmit(t, share, &shares[i])
+	}
+
+	// Moving the encrypted responses to a ciphertext under a different label
+	// must not make trustees release anything for them, as the shared points
+	// do not depend on the label.
+	relabeled := d.NewCiphertext()
+	relabel(t, ct, []byte("other"), relabeled)
+	for _, tr := range trustees {
+		if _, err := cd00.PartialDecrypt[G, P, E](*relabeled, []byte("other"), tr); err == nil {
+			t.Error("trustee should refuse entries bound to a different label")
+		}
+	}
+
+	wDec, err := d.Decrypt(ct, x, label, shares[1:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(encoder.EncodeWitness(w), encoder.EncodeWitness(wDec)) {
+		t.Error("decryption should equal encryption")
+	}
+	if _, err := d.Decrypt(ct, x, label, shares[:1]); err == nil {
+		t.Error("decryption should require the threshold number of shares")
+	}
+	if _, err := d.Decrypt(ct, x, []byte("other"), shares); err == nil {
+		t.Error("should refuse decryption under a different label")
+	}
+}
+
+// newThresholdInstance generates a t-out-of-n ECIES key with the distributed
+// key generation of elgamal/enc.
+func newThresholdInstance[C curve.Curve](
+	t *testing.T,
+	g curve.Generator[C],
+	rnd io.Reader,
+	threshold, n int,
+) (ecies.Encrypter[C], []ecies.Trustee[C], ecies.Combiner[C]) {
+	parties := make([]elgamal.Cipher[C], n)
+	commitments := make([]elgamal.Commitments[C], n)
+	dealings := make([]elgamal.Dealing[C], n)
+	for i := range parties {
+		parties[i] = elgamal.NewCipher(g, rnd)
+		d, err := parties[i].Deal(i+1, threshold, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dealings[i] = d
+		commitments[i] = d.Commitments()
+	}
+
+	trustees := make([]ecies.Trustee[C], n)
+	for j := range trustees {
+		shares := make([]curve.Scalar[C], n)
+		for i, d := range dealings {
+			shares[i] = d.Share(j + 1)
+		}
+		ks, err := parties[j].NewKeyShare(j+1, commitments, shares)
+		if err != nil {
+			t.Fatal(err)
+		}
+		trustees[j] = ecies.NewTrustee(g, ks, rnd)
+	}
+
+	tk, err := parties[0].NewThresholdKey(n, commitments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	comb, err := ecies.NewCombiner(g, tk.Threshold(), tk.VerificationKeys())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ecies.NewEncrypter[C](g, ecies.PubKey[C](tk.PubKey())), trustees, comb
+}
+
+func TestProtocol_audit(t *testing.T) {
+	type G = secp256k1.Curve
+	type P = dlog.Protocol
+	type E = ecies.Scheme[edwards25519.Curve]
+	type C = sha256.Scheme
+	rnd := rand.Reader
+	g := secp256k1.NewGenerator()
+	p := dlog.NewProver[G](g, rnd)
+	v := dlog.NewVerifier[G](g, rnd)
+	ext := dlog.NewExtractor[G](g)
+	encoder := dlog.NewEncoder[G](g)
+	encrypter, decrypter, err := ecies.NewInstance[edwards25519.Curve](edwards25519.NewGenerator(), rnd)
+	if err != nil {
+		panic(err)
+	}
+	prover := cd00.NewProver[G, P, E, C](K, p, v, encoder, encrypter, sha256.NewCommitter(rnd), rnd, cd00.WithOpenedRounds(U))
+	verifier := cd00.NewVerifier[G, P, E, C](rnd, K, U, sha256.NewVerifier(), v, encoder, encrypter)
+	d := cd00.NewDecrypter[G, P, E](v, ext, encoder, decrypter)
+	a := cd00.NewAuditor[G, P, E](v, ext, encoder, encrypter)
+
+	w, err := g.RandomScalar(rnd)
+	if err != nil {
+		panic(err)
+	}
+	x := g.Generator().Mul(w)
+	label := []byte("escrow")
+	proof, err := prover.Prove(x, w, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct, err := verifier.VerifyProof(x, label, proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Successful decryption.
+	decProof, err := d.ProveDecryption(rnd, ct, x, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decProofRecv cd00.DecryptionProof[E]
+	wiretest.Transmit(t, decProof, &decProofRecv)
+	wDec, err := a.VerifyDecryption(ct, x, label, decProofRecv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(encoder.EncodeWitness(w), encoder.EncodeWitness(wDec)) {
+		t.Error("audited witness should equal encrypted witness")
+	}
+	if _, err := a.VerifyDecryption(ct, x, []byte("other"), decProofRecv); err == nil {
+		t.Error("should reject proof under a different label")
+	}
+
+	// Decryption failure: no entry decrypts under a different key.
+	otherEncrypter, otherDecrypter, err := ecies.NewInstance[edwards25519.Curve](edwards25519.NewGenerator(), rnd)
+	if err != nil {
+		panic(err)
+	}
+	dOther := cd00.NewDecrypter[G, P, E](v, ext, encoder, otherDecrypter)
+	aOther := cd00.NewAuditor[G, P, E](v, ext, encoder, otherEncrypter)
+	failProof, err := dOther.ProveDecryption(rnd, ct, x, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := aOther.VerifyDecryption(ct, x, label, failProof); !errors.Is(err, cd00.ErrDecryptionFailure) {
+		t.Errorf("proof should show decryption failure, got %v", err)
+	}
+	if _, err := a.VerifyDecryption(ct, x, label, failProof); err == nil || errors.Is(err, cd00.ErrDecryptionFailure) {
+		t.Error("should reject proof for a different key")
+	}
+	if _, err := aOther.VerifyDecryption(ct, x, label, decProofRecv); err == nil || errors.Is(err, cd00.ErrDecryptionFailure) {
+		t.Error("should reject proof for a different key")
+	}
+
+	// Paillier ciphertexts cannot be checked publicly for their label, so
+	// decryption proofs are not supported.
+	_, paillierDecrypter, err := paillier.NewInstance(rnd, 1024)
+	if err != nil {
+		panic(err)
+	}
+	dPaillier := cd00.NewDecrypter[G, P, paillier.Scheme](v, ext, encoder, paillierDecrypter)
+	if _, err := dPaillier.ProveDecryption(rnd, cd00.Ciphertext[G, P, paillier.Scheme]{}, x, label); err == nil {
+		t.Error("decryption proofs should not be supported for Paillier")
+	}
+}
+
+// TestProtocol_auditRelabeled checks that moving the encrypted responses of a
+// ciphertext to a ciphertext under a different label does not make the
+// decrypter reveal anything about them in a decryption proof.
+func TestProtocol_auditRelabeled(t *testing.T) {
+	type G = secp256k1.Curve
+	type P = dlog.Protocol
+	type E = ecies.Scheme[edwards25519.Curve]
+	type C = sha256.Scheme
+	rnd := rand.Reader
+	g := secp256k1.NewGenerator()
+	p := dlog.NewProver[G](g, rnd)
+	v := dlog.NewVerifier[G](g, rnd)
+	ext := dlog.NewExtractor[G](g)
+	encoder := dlog.NewEncoder[G](g)
+	encrypter, decrypter, err := ecies.NewInstance[edwards25519.Curve](edwards25519.NewGenerator(), rnd)
+	if err != nil {
+		panic(err)
+	}
+	prover := cd00.NewProver[G, P, E, C](K, p, v, encoder, encrypter, sha256.NewCommitter(rnd), rnd, cd00.WithOpenedRounds(U))
+	verifier := cd00.NewVerifier[G, P, E, C](rnd, K, U, sha256.NewVerifier(), v, encoder, encrypter)
+	d := cd00.NewDecrypter[G, P, E](v, ext, encoder, decrypter)
+	a := cd00.NewAuditor[G, P, E](v, ext, encoder, encrypter)
+
+	w, err := g.RandomScalar(rnd)
+	if err != nil {
+		panic(err)
+	}
+	x := g.Generator().Mul(w)
+	proof, err := prover.Prove(x, w, []byte("escrow"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct, err := verifier.VerifyProof(x, []byte("escrow"), proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	label := []byte("other")
+	relabeled := d.NewCiphertext()
+	relabel(t, ct, label, relabeled)
+	decProof, err := d.ProveDecryption(rnd, *relabeled, x, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The encoded proof consists of the version, the number of entries and an
+	// empty length-prefixed proof for each entry.
+	data, err := decProof.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) != 1+8+8*U || !bytes.Equal(data[9:], make([]byte, 8*U)) {
+		t.Error("decryption proof should not reveal anything about relabeled entries")
+	}
+	if _, err := a.VerifyDecryption(*relabeled, x, label, decProof); !errors.Is(err, cd00.ErrDecryptionFailure) {
+		t.Errorf("decryption proof should show failure for relabeled entries, got %v", err)
+	}
+}
+
+// relabel re-encodes the ciphertext ct with the label replaced by label, as an
+// attacker could do on the wire, and decodes it into out.
+func relabel(t *testing.T, ct encoding.BinaryMarshaler, label []byte, out encoding.BinaryUnmarshaler) {
+	data, err := ct.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The label follows the version byte and is prefixed with its length.
+	l := int(binary.BigEndian.Uint64(data[1:9]))
+	relabeled := append([]byte{data[0]}, binary.BigEndian.AppendUint64(nil, uint64(len(label)))...)
+	relabeled = append(relabeled, label...)
+	relabeled = append(relabeled, data[9+l:]...)
+	if err := out.UnmarshalBinary(relabeled); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// yieldingReader yields the processor before each read, so that concurrent
+// readers interleave even on a single CPU.
+type yieldingReader struct {
+	r io.Reader
+}
+
+func (r yieldingReader) Read(p []byte) (int, error) {
+	runtime.Gosched()
+	return r.r.Read(p)
+}
+
 func setupAndRun[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Scheme](
 	t *testing.T,
 	rnd io.Reader,
@@ -52,9 +454,10 @@ func setupAndRun[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Sch
 	sigmaEnc sigma.Encoder[G, P],
 	encrypter probenc.Encrypter[E],
 	decrypter probenc.Decrypter[E],
+	opts ...cd00.Option,
 ) {
-	p := cd00.NewProver(K, sigmaP, sigmaV, sigmaEnc, encrypter, commC, rnd)
-	v := cd00.NewVerifier(rnd, K, U, commV, sigmaV, sigmaEnc, encrypter)
+	p := cd00.NewProver(K, sigmaP, sigmaV, sigmaEnc, encrypter, commC, rnd, append([]cd00.Option{cd00.WithOpenedRounds(U)}, opts...)...)
+	v := cd00.NewVerifier(rnd, K, U, commV, sigmaV, sigmaEnc, encrypter, opts...)
 	d := cd00.NewDecrypter(sigmaV, sigmaExt, sigmaEnc, decrypter)
 
 	w, err := g.RandomScalar(rnd)
@@ -66,6 +469,10 @@ func setupAndRun[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Sch
 		t, p, v, d, x, w,
 		sigmaEnc,
 	)
+	runProof[G, P](
+		t, p, v, d, x, w,
+		sigmaEnc,
+	)
 }
 
 func runProtocol[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Scheme](
@@ -77,24 +484,50 @@ func runProtocol[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Sch
 	w sigma.Witness[G, P],
 	encoder sigma.Encoder[G, P],
 ) {
-	com, decom, err := p.Commit(x, w)
+	// Each message is sent over the wire to check the binary encoding.
+	label := []byte("transaction")
+	com, decom, err := p.Commit(x, w, label)
 	if err != nil {
 		t.Fatal(err)
 	}
+	var comRecv cd00.Commitment[C]
+	wiretest.Transmit(t, com, &comRecv)
 
-	ch, err := v.Challenge(com)
+	ch, err := v.Challenge(comRecv)
 	if err != nil {
 		t.Fatal(err)
 	}
+	var chRecv cd00.Challenge
+	wiretest.Transmit(t, ch, &chRecv)
 
-	resp := p.Respond(decom, ch)
+	resp, err := p.Respond(decom, chRecv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	respRecv := v.NewResponse()
+	wiretest.Transmit(t, resp, respRecv)
 
-	ct, err := v.Verify(x, com, ch, resp)
+	ct, err := v.Verify(x, label, comRecv, ch, *respRecv)
 	if err != nil {
 		t.Fatal(err)
 	}
+	if _, err := v.Verify(x, []byte("other"), comRecv, ch, *respRecv); err == nil {
+		t.Error("should reject response under a different label")
+	}
+	ctRecv := d.NewCiphertext()
+	wiretest.Transmit(t, ct, ctRecv)
+
+	if _, err := p.Respond(decom, cd00.Challenge{0, 0}); err == nil {
+		t.Error("should reject challenge with repeated index")
+	}
+	if _, err := p.Respond(decom, cd00.Challenge{K}); err == nil {
+		t.Error("should reject challenge with index out of range")
+	}
 
-	wDec, err := d.Decrypt(ct, x)
+	if _, err := d.Decrypt(*ctRecv, x, []byte("other")); err == nil {
+		t.Error("should refuse decryption under a different label")
+	}
+	wDec, err := d.Decrypt(*ctRecv, x, label)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -106,3 +539,47 @@ func runProtocol[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Sch
 		t.Error("decryption should equal encryption")
 	}
 }
+
+func runProof[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Scheme](
+	t *testing.T,
+	p *cd00.Prover[G, P, E, C],
+	v *cd00.Verifier[G, P, E, C],
+	d *cd00.Decrypter[G, P, E],
+	x sigma.Word[G, P],
+	w sigma.Witness[G, P],
+	encoder sigma.Encoder[G, P],
+) {
+	label := []byte("escrow")
+	proof, err := p.Prove(x, w, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ct, err := v.VerifyProof(x, label, proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := d.Decrypt(ct, x, nil); err == nil {
+		t.Error("should refuse decryption under a different label")
+	}
+	wDec, err := d.Decrypt(ct, x, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(encoder.EncodeWitness(w), encoder.EncodeWitness(wDec)) {
+		t.Error("decryption should equal encryption")
+	}
+
+	if _, err := v.VerifyProof(x, []byte("other"), proof); err == nil {
+		t.Error("proof should not verify under a different label")
+	}
+	tampered := append(cd00.Proof(nil), proof...)
+	tampered[len(tampered)-1] ^= 1
+	if _, err := v.VerifyProof(x, label, tampered); err == nil {
+		t.Error("tampered proof should not verify")
+	}
+	if _, err := v.VerifyProof(x, label, proof[:len(proof)-1]); err == nil {
+		t.Error("truncated proof should not verify")
+	}
+}
@@ -18,6 +18,8 @@ type Prover[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Scheme]
 	committer commit.Committer[C]
 	rnd       io.Reader
 	k         uint
+	u         uint
+	workers   int
 }
 
 type Commitment[C commit.Scheme] commit.Commitment[C]
@@ -25,6 +27,7 @@ type Commitment[C commit.Scheme] commit.Commitment[C]
 type Decommitment[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Scheme] struct {
 	x       sigma.Word[G, P]
 	w       sigma.Witness[G, P]
+	label   []byte
 	decomms []sigma.Decommitment[C, P]
 	s       []EncryptedResponse[G, P, E]
 	r       []probenc.RandomBytes
@@ -34,8 +37,15 @@ type Decommitment[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Sc
 type Challenge []uint
 
 type Response[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Scheme] struct {
+	proofResponse[G, P, E]
+	d       commit.Decommitment[C]
+	encoder *encoder[G, P, E]
+}
+
+// proofResponse is the part of a response that does not depend on the
+// commitment scheme.
+type proofResponse[G curve.Curve, P sigma.Protocol, E probenc.Scheme] struct {
 	encResps []EncryptedResponse[G, P, E]
-	d        commit.Decommitment[C]
 	s        []sigma.Response[G, P]
 	r        []probenc.RandomBytes
 }
@@ -53,7 +63,9 @@ func NewProver[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Schem
 	encrypter probenc.Encrypter[E],
 	committer commit.Committer[C],
 	rnd io.Reader,
+	opts ...Option,
 ) *Prover[G, P, E, C] {
+	o := makeOptions(opts)
 	return &Prover[G, P, E, C]{
 		sigmaP:    p,
 		sigmaV:    v,
@@ -62,38 +74,29 @@ func NewProver[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Schem
 		committer: committer,
 		rnd:       rnd,
 		k:         k,
+		u:         o.u,
+		workers:   o.workers,
 	}
 }
 
+// Commit encrypts the responses under the given label and commits to them
+// together with the label. The label binds the ciphertext to a context, such
+// as a transaction, and must be presented again for decryption.
 func (p Prover[G, P, E, C]) Commit(
 	x sigma.Word[G, P],
 	w sigma.Witness[G, P],
+	label []byte,
 ) (
 	Commitment[C],
 	Decommitment[G, P, E, C],
 	error,
 ) {
-	decomms := make([]sigma.Decommitment[C, P], p.k)
-	encResps := make([]EncryptedResponse[G, P, E], p.k)
-	rands := make([]probenc.RandomBytes, p.k)
-	for i := uint(0); i < p.k; i++ {
-		t, rt, err := p.sigmaP.Commit(x, w)
-		if err != nil {
-			return nil, Decommitment[G, P, E, C]{}, fmt.Errorf("sigma protocol commit: %w", err)
-		}
-		ch0 := sigma.Challenge(false)
-		s0 := p.sigmaP.Respond(x, w, rt, ch0)
-		s0Bytes := p.encoder.EncodeResponse(s0)
-		e0, r0, err := probenc.Encrypt(p.rnd, s0Bytes, p.encrypter)
-		if err != nil {
-			return nil, Decommitment[G, P, E, C]{}, fmt.Errorf("encrypting response %d: %w", i, err)
-		}
-		decomms[i] = rt
-		encResps[i] = EncryptedResponse[G, P, E]{t, e0}
-		rands[i] = r0
+	decomms, encResps, rands, err := p.encryptResponses(x, w, label)
+	if err != nil {
+		return nil, Decommitment[G, P, E, C]{}, err
 	}
 
-	data, err := p.encoder.EncodeEncryptedResponses(encResps)
+	data, err := p.encoder.encodeCommitted(label, encResps)
 	if err != nil {
 		return nil, Decommitment[G, P, E, C]{}, fmt.Errorf("encoding encrypted responses: %w", err)
 	}
@@ -101,10 +104,11 @@ func (p Prover[G, P, E, C]) Commit(
 	if err != nil {
 		return nil, Decommitment[G, P, E, C]{}, fmt.Errorf("committing responses: %w", err)
 	}
-	com := respCom
+	com := Commitment[C](respCom)
 	decom := Decommitment[G, P, E, C]{
 		x,
 		w,
+		label,
 		decomms,
 		encResps,
 		rands,
@@ -113,13 +117,67 @@ func (p Prover[G, P, E, C]) Commit(
 	return com, decom, nil
 }
 
+// encryptResponses runs k instances of the sigma protocol and encrypts the
+// responses for challenge 0. The instances are processed in parallel. The
+// randomness of each instance is derived from a seed read from the randomness
+// source of the prover, so that the output does not depend on the number of
+// workers. If the sigma prover implements sigma.RandProver, this includes the
+// randomness of its commitments.
+func (p Prover[G, P, E, C]) encryptResponses(
+	x sigma.Word[G, P],
+	w sigma.Witness[G, P],
+	label []byte,
+) (
+	[]sigma.Decommitment[C, P],
+	[]EncryptedResponse[G, P, E],
+	[]probenc.RandomBytes,
+	error,
+) {
+	decomms := make([]sigma.Decommitment[C, P], p.k)
+	encResps := make([]EncryptedResponse[G, P, E], p.k)
+	rands := make([]probenc.RandomBytes, p.k)
+	rnds, err := newRoundReaders(p.rnd)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	err = parallel(p.workers, p.k, func(i uint) error {
+		rnd := rnds.reader(i)
+		sigmaP := p.sigmaP
+		if rp, ok := sigmaP.(sigma.RandProver[G, P]); ok {
+			sigmaP = rp.WithRand(rnd)
+		}
+		t, rt, err := sigmaP.Commit(x, w)
+		if err != nil {
+			return fmt.Errorf("sigma protocol commit: %w", err)
+		}
+		ch0 := sigma.Challenge(false)
+		s0 := sigmaP.Respond(x, w, rt, ch0)
+		s0Bytes := p.encoder.EncodeResponse(s0)
+		e0, r0, err := probenc.Encrypt(rnd, s0Bytes, label, p.encrypter)
+		if err != nil {
+			return fmt.Errorf("encrypting response %d: %w", i, err)
+		}
+		decomms[i] = rt
+		encResps[i] = EncryptedResponse[G, P, E]{t, e0}
+		rands[i] = r0
+		return nil
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return decomms, encResps, rands, nil
+}
+
 func (p Prover[G, P, E, C]) Respond(
 	decom Decommitment[G, P, E, C],
 	ch Challenge,
-) Response[G, P, E, C] {
+) (Response[G, P, E, C], error) {
 	// choices = [i in challenge]_{i in {0, ..., k-1}}.
 	choices := make([]bool, p.k)
 	for i := 0; i < len(ch); i++ {
+		if ch[i] >= p.k || choices[ch[i]] {
+			return Response[G, P, E, C]{}, fmt.Errorf("invalid challenge")
+		}
 		choices[ch[i]] = true
 	}
 
@@ -137,9 +195,12 @@ func (p Prover[G, P, E, C]) Respond(
 	}
 
 	return Response[G, P, E, C]{
-		encResps: decom.s,
-		d:        decom.decom,
-		s:        responses,
-		r:        rbs,
-	}
+		proofResponse: proofResponse[G, P, E]{
+			encResps: decom.s,
+			s:        responses,
+			r:        rbs,
+		},
+		d:       decom.decom,
+		encoder: p.encoder,
+	}, nil
 }
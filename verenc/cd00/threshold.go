@@ -0,0 +1,91 @@
+package cd00
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	sigma "github.com/matthiasgeihs/go-curve/sigma/binary"
+	"github.com/matthiasgeihs/go-curve/verenc/cd00/probenc"
+)
+
+// DecryptionShare holds the partial decryptions of one trustee for all
+// encrypted responses of a ciphertext.
+type DecryptionShare[E probenc.Scheme] struct {
+	shares []probenc.DecryptionShare[E]
+}
+
+// PartialDecrypt computes the decryption share of a trustee for the
+// ciphertext. It refuses ciphertexts that are not bound to the given label.
+func PartialDecrypt[G curve.Curve, P sigma.Protocol, E probenc.Scheme](
+	ct Ciphertext[G, P, E],
+	label []byte,
+	pd probenc.PartialDecrypter[E],
+) (DecryptionShare[E], error) {
+	if !bytes.Equal(ct.label, label) {
+		return DecryptionShare[E]{}, fmt.Errorf("label mismatch")
+	}
+	shares := make([]probenc.DecryptionShare[E], len(ct.e))
+	for i, e := range ct.e {
+		var err error
+		shares[i], err = pd.PartialDecrypt(e, label)
+		if err != nil {
+			return DecryptionShare[E]{}, fmt.Errorf("partially decrypting entry %d: %w", i, err)
+		}
+	}
+	return DecryptionShare[E]{shares}, nil
+}
+
+// ThresholdDecrypter decrypts ciphertexts whose responses are encrypted
+// under a threshold scheme by combining the decryption shares of the
+// trustees.
+type ThresholdDecrypter[G curve.Curve, P sigma.Protocol, E probenc.Scheme] struct {
+	ver      sigma.Verifier[G, P]
+	ext      sigma.Extractor[G, P]
+	encoder  sigma.Encoder[G, P]
+	combiner probenc.Combiner[E]
+}
+
+func NewThresholdDecrypter[
+	G curve.Curve,
+	P sigma.Protocol,
+	E probenc.Scheme,
+](
+	ver sigma.Verifier[G, P],
+	ext sigma.Extractor[G, P],
+	encoder sigma.Encoder[G, P],
+	combiner probenc.Combiner[E],
+) *ThresholdDecrypter[G, P, E] {
+	return &ThresholdDecrypter[G, P, E]{
+		ver:      ver,
+		ext:      ext,
+		encoder:  encoder,
+		combiner: combiner,
+	}
+}
+
+// Decrypt combines the decryption shares and returns the witness for x. It
+// refuses ciphertexts that are not bound to the given label. Shares with an
+// invalid proof are ignored by the combiner.
+func (d *ThresholdDecrypter[G, P, E]) Decrypt(
+	ct Ciphertext[G, P, E],
+	x sigma.Word[G, P],
+	label []byte,
+	shares []DecryptionShare[E],
+) (sigma.Witness[G, P], error) {
+	if !bytes.Equal(ct.label, label) {
+		return nil, fmt.Errorf("label mismatch")
+	}
+	for j, share := range shares {
+		if len(share.shares) != len(ct.e) {
+			return nil, fmt.Errorf("share %d: invalid length: %d", j, len(share.shares))
+		}
+	}
+	return extract(d.ver, d.ext, d.encoder, ct, x, func(i int) ([]byte, error) {
+		entryShares := make([]probenc.DecryptionShare[E], len(shares))
+		for j, share := range shares {
+			entryShares[j] = share.shares[i]
+		}
+		return d.combiner.Combine(ct.e[i], label, entryShares)
+	})
+}
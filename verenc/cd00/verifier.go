@@ -2,10 +2,10 @@ package cd00
 
 import (
 	"bytes"
-	"crypto/rand"
+	"encoding/binary"
 	"fmt"
 	"io"
-	"math/big"
+	"math"
 
 	"github.com/matthiasgeihs/go-curve/commit"
 	"github.com/matthiasgeihs/go-curve/curve"
@@ -21,12 +21,15 @@ type Verifier[G curve.Curve, P sigma.Protocol, E probenc.Scheme, C commit.Scheme
 	comV      commit.Verifier[C]
 	sigmaV    sigma.Verifier[G, P]
 	encrypter probenc.Encrypter[E]
+	workers   int
 }
 
 type Ciphertext[G curve.Curve, P sigma.Protocol, E probenc.Scheme] struct {
-	t []sigma.Commitment[G, P]
-	s []sigma.Response[G, P]
-	e []probenc.Ciphertext[E]
+	label   []byte
+	t       []sigma.Commitment[G, P]
+	s       []sigma.Response[G, P]
+	e       []probenc.Ciphertext[E]
+	encoder *encoder[G, P, E]
 }
 
 func NewVerifier[
@@ -42,7 +45,9 @@ func NewVerifier[
 	sigmaV sigma.Verifier[G, P],
 	sigmaEnc sigma.Encoder[G, P],
 	encrypter probenc.Encrypter[E],
+	opts ...Option,
 ) *Verifier[G, P, E, C] {
+	o := makeOptions(opts)
 	return &Verifier[G, P, E, C]{
 		rnd:       rnd,
 		k:         k,
@@ -51,6 +56,7 @@ func NewVerifier[
 		comV:      comV,
 		sigmaV:    sigmaV,
 		encrypter: encrypter,
+		workers:   o.workers,
 	}
 }
 
@@ -72,13 +78,12 @@ func nChooseK(n, k uint, rnd io.Reader) ([]uint, error) {
 
 	// Select k numbers from bag at random.
 	selection := make([]uint, k)
-	l := int64(len(bag))
+	l := uint64(len(bag))
 	for i := uint(0); i < k; i++ {
-		jBig, err := rand.Int(rnd, big.NewInt(l))
+		j, err := uniformUint64(rnd, l)
 		if err != nil {
 			return nil, fmt.Errorf("sampling random number: %w", err)
 		}
-		j := jBig.Int64()
 		selection[i] = bag[j]
 
 		// Swap out chosen element.
@@ -90,72 +95,111 @@ func nChooseK(n, k uint, rnd io.Reader) ([]uint, error) {
 	return selection, nil
 }
 
+// uniformUint64 returns a uniformly random number from {0, ..., n-1}. It reads
+// 8 bytes at a time and rejects values that would introduce a bias.
+func uniformUint64(rnd io.Reader, n uint64) (uint64, error) {
+	// Largest multiple of n that fits into 64 bits, minus one.
+	limit := math.MaxUint64 - (math.MaxUint64%n+1)%n
+	var buf [8]byte
+	for {
+		if _, err := io.ReadFull(rnd, buf[:]); err != nil {
+			return 0, err
+		}
+		if r := binary.BigEndian.Uint64(buf[:]); r <= limit {
+			return r % n, nil
+		}
+	}
+}
+
+// Verify verifies the response of the prover for x and the label the prover
+// committed to, and returns the ciphertext bound to that label on success.
 func (v *Verifier[G, P, E, C]) Verify(
 	x sigma.Word[G, P],
-	com Commitment[G],
+	label []byte,
+	com Commitment[C],
 	ch Challenge,
 	resp Response[G, P, E, C],
 ) (Ciphertext[G, P, E], error) {
 	// Open commitment.
-	encRespsBytes, err := v.encoder.EncodeEncryptedResponses(resp.encResps)
+	data, err := v.encoder.encodeCommitted(label, resp.encResps)
 	if err != nil {
-		return Ciphertext[G, P, E]{}, fmt.Errorf("encoding encrypted responses: %w", err)
+		return Ciphertext[G, P, E]{}, err
 	}
-	err = v.comV.Verify(com, resp.d, encRespsBytes)
+	err = v.comV.Verify(commit.Commitment[C](com), resp.d, data)
 	if err != nil {
 		return Ciphertext[G, P, E]{}, fmt.Errorf("verifying commitment: %w", err)
 	}
 
-	// Verify sigma responses.
+	return v.verifyResponses(x, label, ch, resp.proofResponse)
+}
+
+// verifyResponses verifies the sigma responses and the encryptions of the
+// unopened responses, and returns the ciphertext formed by the opened ones.
+func (v *Verifier[G, P, E, C]) verifyResponses(
+	x sigma.Word[G, P],
+	label []byte,
+	ch Challenge,
+	resp proofResponse[G, P, E],
+) (Ciphertext[G, P, E], error) {
+	if uint(len(ch)) != v.u {
+		return Ciphertext[G, P, E]{}, fmt.Errorf("invalid challenge length")
+	}
+	if uint(len(resp.encResps)) != v.k || uint(len(resp.s)) != v.k || uint(len(resp.r)) != v.k {
+		return Ciphertext[G, P, E]{}, fmt.Errorf("invalid response length")
+	}
 
 	// choices = [i in challenge]_{i in {0, ..., k-1}}.
 	choices := make([]bool, v.k)
 	for i := 0; i < len(ch); i++ {
+		if ch[i] >= v.k || choices[ch[i]] {
+			return Ciphertext[G, P, E]{}, fmt.Errorf("invalid challenge")
+		}
 		choices[ch[i]] = true
 	}
 
-	comms := make([]sigma.Commitment[G, P], v.u)
-	resps := make([]sigma.Response[G, P], v.u)
-	encs := make([]probenc.Ciphertext[E], v.u)
-	storeI := 0
+	err := parallel(v.workers, v.k, func(i uint) error {
+		// Verify sigma response for the challenge bit of round i.
+		b := v.sigmaV.Verify(x, resp.encResps[i].t, sigma.Challenge(choices[i]), resp.s[i])
+		if !b {
+			return fmt.Errorf("invalid sigma proof")
+		}
+		if choices[i] {
+			return nil
+		}
+
+		// Check correct encryption.
+		sBytes := v.encoder.EncodeResponse(resp.s[i])
+		rBuf := bytes.NewBuffer(resp.r[i])
+		ctVer, err := v.encrypter.Encrypt(rBuf, sBytes, label)
+		ctCom := resp.encResps[i].e
+		if err != nil {
+			return fmt.Errorf("failed to encrypt: %w", err)
+		} else if !bytes.Equal(ctCom, ctVer) {
+			return fmt.Errorf("invalid encryption")
+		}
+		return nil
+	})
+	if err != nil {
+		return Ciphertext[G, P, E]{}, err
+	}
+
+	// Collect the opened rounds in order.
+	comms := make([]sigma.Commitment[G, P], 0, v.u)
+	resps := make([]sigma.Response[G, P], 0, v.u)
+	encs := make([]probenc.Ciphertext[E], 0, v.u)
 	for i := uint(0); i < v.k; i++ {
 		if choices[i] {
-			// Verify sigma response for challenge 1.
-			comm := resp.encResps[i].t
-			sigmaResp := resp.s[i]
-			b := v.sigmaV.Verify(x, comm, true, sigmaResp)
-			if !b {
-				return Ciphertext[G, P, E]{}, fmt.Errorf("invalid sigma proof")
-			}
-
-			// Append to ciphertext.
-			comms[storeI] = comm
-			resps[storeI] = sigmaResp
-			encs[storeI] = resp.encResps[i].e
-			storeI += 1
-		} else {
-			// Verify sigma response for challenge 0.
-			b := v.sigmaV.Verify(x, resp.encResps[i].t, false, resp.s[i])
-			if !b {
-				return Ciphertext[G, P, E]{}, fmt.Errorf("invalid sigma proof")
-			}
-
-			// Check correct encryption.
-			sBytes := v.encoder.EncodeResponse(resp.s[i])
-			rBuf := bytes.NewBuffer(resp.r[i])
-			ctVer, err := v.encrypter.Encrypt(rBuf, sBytes)
-			ctCom := resp.encResps[i].e
-			if err != nil {
-				return Ciphertext[G, P, E]{}, fmt.Errorf("failed to encrypt: %w", err)
-			} else if !bytes.Equal(ctCom, ctVer) {
-				return Ciphertext[G, P, E]{}, fmt.Errorf("invalid encryption")
-			}
+			comms = append(comms, resp.encResps[i].t)
+			resps = append(resps, resp.s[i])
+			encs = append(encs, resp.encResps[i].e)
 		}
 	}
 
 	return Ciphertext[G, P, E]{
-		t: comms,
-		s: resps,
-		e: encs,
+		label:   append([]byte(nil), label...),
+		t:       comms,
+		s:       resps,
+		e:       encs,
+		encoder: v.encoder,
 	}, nil
 }
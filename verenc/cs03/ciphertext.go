@@ -0,0 +1,75 @@
+package cs03
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+)
+
+// labelDST is the domain separation tag of the hash binding ciphertexts to
+// their label.
+var labelDST = []byte("go-curve-verenc-cs03-label-v1")
+
+type Ciphertext[C curve.Curve] struct {
+	u, e, v *big.Int
+	label   []byte
+}
+
+// Label returns the label the ciphertext is bound to.
+func (ct Ciphertext[C]) Label() []byte {
+	return append([]byte(nil), ct.label...)
+}
+
+// encrypt encrypts m with randomness r under the given label, i.e., computes
+// u = g^r, e = y1^r h^m, v = abs((y2 y3^H(u, e, label))^r).
+func encrypt[C curve.Curve](pk *PublicKey, m, r *big.Int, label []byte) Ciphertext[C] {
+	u := new(big.Int).Exp(pk.g, r, pk.n2)
+	e := new(big.Int).Exp(pk.y1, r, pk.n2)
+	e.Mul(e, pk.hPow(m))
+	e.Mod(e, pk.n2)
+	v := new(big.Int).Exp(pk.vBase(u, e, label), r, pk.n2)
+	return Ciphertext[C]{
+		u:     u,
+		e:     e,
+		v:     pk.abs(v),
+		label: append([]byte(nil), label...),
+	}
+}
+
+// isValid reports whether the ciphertext components are in Z*_{n^2} and v is
+// in canonical form.
+func (ct Ciphertext[C]) isValid(pk *PublicKey) bool {
+	return pk.isElement(ct.u) &&
+		pk.isElement(ct.e) &&
+		pk.isElement(ct.v) &&
+		ct.v.Cmp(new(big.Int).Rsh(pk.n2, 1)) <= 0
+}
+
+// vBase computes y2 y3^H(u, e, label).
+func (pk *PublicKey) vBase(u, e *big.Int, label []byte) *big.Int {
+	hl := labelHash(u, e, label)
+	b := new(big.Int).Exp(pk.y3, hl, pk.n2)
+	b.Mul(b, pk.y2)
+	return b.Mod(b, pk.n2)
+}
+
+// labelHash hashes u, e and the label to a 256-bit integer.
+func labelHash(u, e *big.Int, label []byte) *big.Int {
+	h := sha256.New()
+	h.Write(labelDST)
+	writeHash(h, u.Bytes())
+	writeHash(h, e.Bytes())
+	writeHash(h, label)
+	return new(big.Int).SetBytes(h.Sum(nil))
+}
+
+// writeHash writes the length-prefixed data to h.
+func writeHash(h hash.Hash, data []byte) {
+	var l [8]byte
+	binary.BigEndian.PutUint64(l[:], uint64(len(data)))
+	h.Write(l[:])
+	h.Write(data)
+}
@@ -0,0 +1,67 @@
+package cs03
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/sigma/dlog"
+)
+
+type Decrypter[C curve.Curve] struct {
+	gen curve.Generator[C]
+	sk  *SecretKey
+}
+
+func NewDecrypter[C curve.Curve](
+	gen curve.Generator[C],
+	sk *SecretKey,
+) Decrypter[C] {
+	return Decrypter[C]{
+		gen: gen,
+		sk:  sk,
+	}
+}
+
+// Decrypt decrypts the ciphertext and returns the witness for x.
+func (d Decrypter[C]) Decrypt(
+	ct Ciphertext[C],
+	x dlog.Word[C],
+) (dlog.Witness[C], error) {
+	sk := d.sk
+	if !ct.isValid(&sk.PublicKey) {
+		return nil, fmt.Errorf("invalid ciphertext")
+	}
+
+	// Check u^(2(x2 + H(u, e, label) x3)) == v^2.
+	exp := labelHash(ct.u, ct.e, ct.label)
+	exp.Mul(exp, sk.x3)
+	exp.Add(exp, sk.x2)
+	exp.Lsh(exp, 1)
+	lhs := new(big.Int).Exp(ct.u, exp, sk.n2)
+	rhs := new(big.Int).Exp(ct.v, big.NewInt(2), sk.n2)
+	if lhs.Cmp(rhs) != 0 {
+		return nil, fmt.Errorf("ciphertext check failed")
+	}
+
+	// Compute (e / u^x1)^(2t) with t = 1/2 mod n, which equals 1 + m n.
+	a := new(big.Int).Exp(ct.u, sk.x1, sk.n2)
+	a.ModInverse(a, sk.n2)
+	a.Mul(a, ct.e)
+	a.Exp(a, new(big.Int).Add(sk.n, one), sk.n2)
+	a.Sub(a, one)
+	m, rem := new(big.Int).QuoRem(a, sk.n, new(big.Int))
+	if rem.Sign() != 0 {
+		return nil, fmt.Errorf("invalid plaintext")
+	}
+
+	// Plaintexts extracted from valid proofs may be negative.
+	if m.Cmp(new(big.Int).Rsh(sk.n, 1)) > 0 {
+		m.Sub(m, sk.n)
+	}
+	w := scalar(d.gen, m)
+	if !d.gen.MulBase(w).Equal(x) {
+		return nil, fmt.Errorf("plaintext is not a witness")
+	}
+	return dlog.Witness[C](w), nil
+}
@@ -0,0 +1,40 @@
+// cs03 implements verifiable encryption of discrete logarithms from Camenisch
+// and Shoup, "Practical Verifiable Encryption and Decryption of Discrete
+// Logarithms", CRYPTO 2003.
+//
+// The witness w of a dlog word x = w*G is encrypted under the CCA2-secure
+// Camenisch-Shoup cryptosystem over Z*_{n^2} and committed to in the
+// auxiliary group of squares modulo an RSA modulus N as C = g'^w h'^s. A
+// single Sigma proof made non-interactive via Fiat-Shamir shows that the
+// ciphertext and the commitment contain the same integer m and that m*G = x,
+// and bounds m with a range check on the response, as in Section 5 of the
+// paper.
+//
+// Extraction works as follows. Two accepting proofs with the same commitments
+// and challenges c > c' yield responses (zr, zs, zm) and (zr', zs', zm'). Let
+// dc = c - c', dr = zr' - zr, ds = zs' - zs and dm = zm' - zm. The
+// verification equations give
+//
+//	u^(2 dc) = g^(2 dr), e^(2 dc) = y1^(2 dr) h^(2 dm),
+//	C^dc = g'^dm h'^ds and dc*x = dm*G.
+//
+// The equations over Z*_{n^2} alone do not force dc to divide dm, as h = 1+n
+// has the public order n. The commitment does: for a prover that does not
+// know the factorization of N, the strong RSA assumption implies that dc
+// divides dm, as for the integer commitments of Fujisaki-Okamoto and
+// Damgard-Fujisaki. Let m = dm/dc. Then
+//
+//	(e / (u^x1 h^m))^(2 dc) = y1^(2 dr) / g^(2 dr x1) = 1.
+//
+// As n is the product of safe primes larger than 2^challengeBits, the only
+// elements of Z*_{n^2} with order dividing 2 dc have order at most 2. Hence
+// e^2 = (u^x1 h^m)^2, so the ciphertext decrypts to m mod n, and the curve
+// equation gives m*G = x as dc is invertible modulo q. The range check
+// 0 <= zm < 2^(k+s) q, where k = challengeBits and s = slackBits, bounds
+// |m| <= |dm| < n/2, so that decryption recovers m itself rather than only
+// m mod n, and m mod q is the witness.
+//
+// The auxiliary parameters are generated along with the key pair and the
+// factorization of N is discarded. Soundness thus relies on the key holder
+// having done so, which matches the trust placed in it to decrypt.
+package cs03
@@ -0,0 +1,86 @@
+package cs03
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+)
+
+// version is the version of the proof encoding.
+const version byte = 1
+
+type proof[C curve.Curve] struct {
+	ct            Ciphertext[C]
+	cm            *big.Int
+	c, zr, zs, zm *big.Int
+}
+
+// proofLayout returns the lengths of the fixed-size proof components.
+func proofLayout(pk *PublicKey, b bounds) []int {
+	l := pk.modBytes()
+	return []int{
+		l, l, l,
+		pk.auxBytes(),
+		challengeBits / 8,
+		(b.zr.BitLen() + 7) / 8,
+		(b.zs.BitLen() + 7) / 8,
+		(b.zm.BitLen() + 7) / 8,
+	}
+}
+
+// encodeProof encodes the proof as a version byte followed by the fixed-length
+// big-endian encodings of u, e, v, the commitment, c, zr, zs and zm.
+func encodeProof[C curve.Curve](pk *PublicKey, b bounds, pr proof[C]) []byte {
+	layout := proofLayout(pk, b)
+	values := []*big.Int{pr.ct.u, pr.ct.e, pr.ct.v, pr.cm, pr.c, pr.zr, pr.zs, pr.zm}
+	data := []byte{version}
+	for i, a := range values {
+		data = append(data, a.FillBytes(make([]byte, layout[i]))...)
+	}
+	return data
+}
+
+func decodeProof[C curve.Curve](pk *PublicKey, b bounds, data []byte, label []byte) (proof[C], error) {
+	if len(data) == 0 || data[0] != version {
+		return proof[C]{}, fmt.Errorf("unsupported version")
+	}
+	data = data[1:]
+	layout := proofLayout(pk, b)
+	total := 0
+	for _, l := range layout {
+		total += l
+	}
+	if len(data) != total {
+		return proof[C]{}, fmt.Errorf("invalid length: %d", len(data))
+	}
+	values := make([]*big.Int, len(layout))
+	for i, l := range layout {
+		values[i] = new(big.Int).SetBytes(data[:l])
+		data = data[l:]
+	}
+
+	pr := proof[C]{
+		ct: Ciphertext[C]{
+			u:     values[0],
+			e:     values[1],
+			v:     values[2],
+			label: append([]byte(nil), label...),
+		},
+		cm: values[3],
+		c:  values[4],
+		zr: values[5],
+		zs: values[6],
+		zm: values[7],
+	}
+	if !pr.ct.isValid(pk) {
+		return proof[C]{}, fmt.Errorf("invalid ciphertext")
+	}
+	if !pk.isAuxElement(pr.cm) {
+		return proof[C]{}, fmt.Errorf("invalid commitment")
+	}
+	if pr.zr.Cmp(b.zr) >= 0 || pr.zs.Cmp(b.zs) >= 0 || pr.zm.Cmp(b.zm) >= 0 {
+		return proof[C]{}, fmt.Errorf("response out of range")
+	}
+	return pr, nil
+}
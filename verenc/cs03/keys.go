@@ -0,0 +1,242 @@
+package cs03
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+var one = big.NewInt(1)
+
+type PublicKey struct {
+	n, n2      *big.Int
+	g          *big.Int
+	y1, y2, y3 *big.Int
+
+	// Auxiliary parameters of the integer commitment. The modulus nAux is a
+	// product of safe primes whose factorization is discarded, and gAux and
+	// hAux generate the subgroup of squares modulo nAux.
+	nAux       *big.Int
+	gAux, hAux *big.Int
+}
+
+type SecretKey struct {
+	PublicKey
+	x1, x2, x3 *big.Int
+}
+
+// GenerateKey generates a key pair with a modulus of the given bit length. The
+// modulus is the product of two safe primes. The auxiliary parameters of the
+// integer commitment use a second modulus of the same form and length.
+func GenerateKey(rnd io.Reader, bits int) (*SecretKey, error) {
+	if bits < 64 {
+		return nil, fmt.Errorf("modulus too small: %d bits", bits)
+	}
+	n, err := safePrimeModulus(rnd, bits)
+	if err != nil {
+		return nil, err
+	}
+	n2 := new(big.Int).Mul(n, n)
+
+	// g = g'^(2n) for random g' generates the subgroup of 2n-th powers.
+	var gp *big.Int
+	for gp == nil || !isUnit(gp, n) {
+		gp, err = rand.Int(rnd, n2)
+		if err != nil {
+			return nil, fmt.Errorf("sampling generator: %w", err)
+		}
+	}
+	g := new(big.Int).Exp(gp, new(big.Int).Lsh(n, 1), n2)
+
+	bound := new(big.Int).Rsh(n2, 2)
+	var x [3]*big.Int
+	var y [3]*big.Int
+	for i := range x {
+		x[i], err = rand.Int(rnd, bound)
+		if err != nil {
+			return nil, fmt.Errorf("sampling secret key: %w", err)
+		}
+		y[i] = new(big.Int).Exp(g, x[i], n2)
+	}
+
+	// hAux is a random square and gAux = hAux^a for random a, so that both
+	// generate the squares modulo nAux with overwhelming probability and
+	// nobody knows the discrete logarithm of gAux to the base hAux once a is
+	// discarded.
+	nAux, err := safePrimeModulus(rnd, bits)
+	if err != nil {
+		return nil, err
+	}
+	var hp *big.Int
+	for hp == nil || !isUnit(hp, nAux) {
+		hp, err = rand.Int(rnd, nAux)
+		if err != nil {
+			return nil, fmt.Errorf("sampling auxiliary generator: %w", err)
+		}
+	}
+	hAux := new(big.Int).Exp(hp, big.NewInt(2), nAux)
+	a, err := rand.Int(rnd, new(big.Int).Rsh(nAux, 2))
+	if err != nil {
+		return nil, fmt.Errorf("sampling auxiliary generator: %w", err)
+	}
+	gAux := new(big.Int).Exp(hAux, a, nAux)
+
+	return &SecretKey{
+		PublicKey: PublicKey{
+			n:    n,
+			n2:   n2,
+			g:    g,
+			y1:   y[0],
+			y2:   y[1],
+			y3:   y[2],
+			nAux: nAux,
+			gAux: gAux,
+			hAux: hAux,
+		},
+		x1: x[0],
+		x2: x[1],
+		x3: x[2],
+	}, nil
+}
+
+func (sk *SecretKey) Public() *PublicKey {
+	return &sk.PublicKey
+}
+
+// N returns the modulus.
+func (pk *PublicKey) N() *big.Int {
+	return new(big.Int).Set(pk.n)
+}
+
+// hPow computes h^m = (1+n)^m = 1 + m*n mod n^2.
+func (pk *PublicKey) hPow(m *big.Int) *big.Int {
+	h := new(big.Int).Mul(m, pk.n)
+	h.Add(h, one)
+	return h.Mod(h, pk.n2)
+}
+
+// abs maps a to n^2 - a if a > n^2/2.
+func (pk *PublicKey) abs(a *big.Int) *big.Int {
+	if a.Cmp(new(big.Int).Rsh(pk.n2, 1)) > 0 {
+		return new(big.Int).Sub(pk.n2, a)
+	}
+	return a
+}
+
+// auxCommit computes the integer commitment gAux^m hAux^s mod nAux.
+func (pk *PublicKey) auxCommit(m, s *big.Int) *big.Int {
+	return expMul(pk.nAux, pk.gAux, m, pk.hAux, s)
+}
+
+// isAuxElement reports whether a is in Z*_nAux.
+func (pk *PublicKey) isAuxElement(a *big.Int) bool {
+	if a.Sign() <= 0 || a.Cmp(pk.nAux) >= 0 {
+		return false
+	}
+	return isUnit(a, pk.nAux)
+}
+
+// isElement reports whether a is in Z*_{n^2}.
+func (pk *PublicKey) isElement(a *big.Int) bool {
+	if a.Sign() <= 0 || a.Cmp(pk.n2) >= 0 {
+		return false
+	}
+	return isUnit(a, pk.n)
+}
+
+// modBytes returns the length of encoded elements of Z*_{n^2}.
+func (pk *PublicKey) modBytes() int {
+	return (pk.n2.BitLen() + 7) / 8
+}
+
+// auxBytes returns the length of encoded elements of Z*_nAux.
+func (pk *PublicKey) auxBytes() int {
+	return (pk.nAux.BitLen() + 7) / 8
+}
+
+func isUnit(a, n *big.Int) bool {
+	return new(big.Int).GCD(nil, nil, a, n).Cmp(one) == 0
+}
+
+// safePrimeModulus returns the product of two distinct safe primes with the
+// given total bit length.
+func safePrimeModulus(rnd io.Reader, bits int) (*big.Int, error) {
+	var p, q *big.Int
+	for p == nil || p.Cmp(q) == 0 {
+		var err error
+		p, err = safePrime(rnd, bits/2)
+		if err != nil {
+			return nil, fmt.Errorf("generating safe prime: %w", err)
+		}
+		q, err = safePrime(rnd, bits-bits/2)
+		if err != nil {
+			return nil, fmt.Errorf("generating safe prime: %w", err)
+		}
+	}
+	return new(big.Int).Mul(p, q), nil
+}
+
+// smallPrimes are the odd primes below 2^12 used for sieving.
+var smallPrimes = func() []uint64 {
+	const max = 1 << 12
+	composite := make([]bool, max)
+	var primes []uint64
+	for i := uint64(3); i < max; i += 2 {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, i)
+		for j := i * i; j < max; j += 2 * i {
+			composite[j] = true
+		}
+	}
+	return primes
+}()
+
+// safePrime returns a prime p = 2q+1 of the given bit length with q prime. The
+// two most significant bits are set, so that the product of two such primes
+// has full length. Candidates for q are sieved jointly for q and 2q+1.
+func safePrime(rnd io.Reader, bits int) (*big.Int, error) {
+	qBits := bits - 1
+	buf := make([]byte, (qBits+7)/8)
+	residues := make([]uint64, len(smallPrimes))
+	for {
+		if _, err := io.ReadFull(rnd, buf); err != nil {
+			return nil, fmt.Errorf("reading randomness: %w", err)
+		}
+		base := new(big.Int).SetBytes(buf)
+		base.Rsh(base, uint(len(buf)*8-qBits))
+		base.SetBit(base, qBits-1, 1)
+		base.SetBit(base, qBits-2, 1)
+		base.SetBit(base, 0, 1)
+
+		s := new(big.Int)
+		for i, pr := range smallPrimes {
+			residues[i] = s.Mod(base, s.SetUint64(pr)).Uint64()
+		}
+
+	search:
+		for delta := uint64(0); delta < 1<<20; delta += 2 {
+			for i, pr := range smallPrimes {
+				// Reject if q or 2q+1 is divisible by pr.
+				r := (residues[i] + delta) % pr
+				if r == 0 || r == (pr-1)/2 {
+					continue search
+				}
+			}
+			q := new(big.Int).Add(base, new(big.Int).SetUint64(delta))
+			if q.BitLen() != qBits {
+				break
+			}
+			if !q.ProbablyPrime(0) {
+				continue
+			}
+			p := new(big.Int).Lsh(q, 1)
+			p.Add(p, one)
+			if p.ProbablyPrime(20) && q.ProbablyPrime(20) {
+				return p, nil
+			}
+		}
+	}
+}
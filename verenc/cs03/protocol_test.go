@@ -0,0 +1,159 @@
+package cs03_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve/p256"
+	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
+	"github.com/matthiasgeihs/go-curve/sigma/dlog"
+	"github.com/matthiasgeihs/go-curve/verenc"
+	"github.com/matthiasgeihs/go-curve/verenc/cs03"
+)
+
+type C = secp256k1.Curve
+
+var _ verenc.Prover[dlog.Word[C], dlog.Witness[C]] = cs03.Prover[C]{}
+var _ verenc.Verifier[dlog.Word[C], cs03.Ciphertext[C]] = cs03.Verifier[C]{}
+var _ verenc.Decrypter[dlog.Word[C], dlog.Witness[C], cs03.Ciphertext[C]] = cs03.Decrypter[C]{}
+
+// Safe prime generation is slow, hence the key is shared between tests.
+var (
+	keyOnce sync.Once
+	key     *cs03.SecretKey
+	keyErr  error
+)
+
+func secretKey(t *testing.T) *cs03.SecretKey {
+	keyOnce.Do(func() {
+		key, keyErr = cs03.GenerateKey(rand.Reader, 1024)
+	})
+	if keyErr != nil {
+		t.Fatal(keyErr)
+	}
+	return key
+}
+
+func TestProtocol_secp256k1(t *testing.T) {
+	testProtocol[secp256k1.Curve](t, secp256k1.NewGenerator())
+}
+
+func TestProtocol_edwards25519(t *testing.T) {
+	testProtocol[edwards25519.Curve](t, edwards25519.NewGenerator())
+}
+
+func TestProtocol_p256(t *testing.T) {
+	testProtocol[p256.Curve](t, p256.NewGenerator())
+}
+
+// TestProtocol_responseRange checks that a proof with a response zm above its
+// bound is rejected when decoding.
+func TestProtocol_responseRange(t *testing.T) {
+	g := secp256k1.NewGenerator()
+	rnd := rand.Reader
+	sk := secretKey(t)
+	p := cs03.NewProver[C](g, sk.Public(), rnd)
+	v := cs03.NewVerifier[C](g, sk.Public())
+
+	w, err := g.RandomScalar(rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := g.MulBase(w)
+	label := []byte("label")
+	proof, err := p.Prove(x, w, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// zm is the last field of the proof. Its bound is q*2^256, which is less
+	// than the maximum value of the field.
+	zmLen := (g.GeneratorOrder().BitLen() + 256 + 7) / 8
+	tampered := append(cs03.Proof(nil), proof...)
+	for i := len(tampered) - zmLen; i < len(tampered); i++ {
+		tampered[i] = 0xff
+	}
+	_, err = v.VerifyProof(x, label, tampered)
+	if err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Errorf("proof with zm out of range should be rejected when decoding, got %v", err)
+	}
+}
+
+func testProtocol[C curve.Curve](t *testing.T, g curve.Generator[C]) {
+	rnd := rand.Reader
+	sk := secretKey(t)
+	if sk.N().BitLen() != 1024 {
+		t.Errorf("modulus should have 1024 bits, has %d", sk.N().BitLen())
+	}
+	p := cs03.NewProver(g, sk.Public(), rnd)
+	v := cs03.NewVerifier(g, sk.Public())
+	d := cs03.NewDecrypter(g, sk)
+
+	w, err := g.RandomScalar(rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := g.MulBase(w)
+	label := []byte("label")
+
+	proof, err := p.Prove(x, w, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct, err := v.VerifyProof(x, label, proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(ct.Label(), label) {
+		t.Error("ciphertext should be bound to label")
+	}
+	wDec, err := d.Decrypt(ct, x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !wDec.Equal(w) {
+		t.Error("decrypted witness should equal witness")
+	}
+
+	// Invalid statements and proofs are rejected.
+	if _, err := v.VerifyProof(x, []byte("other"), proof); err == nil {
+		t.Error("proof should not verify under a different label")
+	}
+	if _, err := v.VerifyProof(x.Add(g.Generator()), label, proof); err == nil {
+		t.Error("proof should not verify for a different word")
+	}
+	if _, err := d.Decrypt(ct, x.Add(g.Generator())); err == nil {
+		t.Error("ciphertext should not decrypt to a witness for a different word")
+	}
+	for _, i := range []int{0, 1, len(proof) / 2, len(proof) - 1} {
+		tampered := append(cs03.Proof(nil), proof...)
+		tampered[i] ^= 1
+		if _, err := v.VerifyProof(x, label, tampered); err == nil {
+			t.Errorf("proof with byte %d flipped should not verify", i)
+		}
+	}
+	if _, err := v.VerifyProof(x, label, proof[:len(proof)-1]); err == nil {
+		t.Error("truncated proof should not verify")
+	}
+	if _, err := v.VerifyProof(x, label, append(proof, 0)); err == nil {
+		t.Error("extended proof should not verify")
+	}
+
+	// A proof for a wrong witness is rejected.
+	w2, err := g.RandomScalar(rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof2, err := p.Prove(x, w2, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.VerifyProof(x, label, proof2); err == nil {
+		t.Error("proof for wrong witness should not verify")
+	}
+}
@@ -0,0 +1,167 @@
+package cs03
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/sigma/dlog"
+	"github.com/matthiasgeihs/go-curve/verenc"
+)
+
+const (
+	// challengeBits is the bit length of the Fiat-Shamir challenge.
+	challengeBits = 128
+	// slackBits is the statistical hiding parameter of the responses.
+	slackBits = 128
+)
+
+// challengeDST is the domain separation tag of the Fiat-Shamir challenge.
+var challengeDST = []byte("go-curve-verenc-cs03-v1")
+
+// Proof is a serialized proof containing the ciphertext.
+type Proof = verenc.Proof
+
+type Prover[C curve.Curve] struct {
+	gen curve.Generator[C]
+	pk  *PublicKey
+	rnd io.Reader
+}
+
+func NewProver[C curve.Curve](
+	gen curve.Generator[C],
+	pk *PublicKey,
+	rnd io.Reader,
+) Prover[C] {
+	return Prover[C]{
+		gen: gen,
+		pk:  pk,
+		rnd: rnd,
+	}
+}
+
+// Prove encrypts w under the given label, commits to w in the auxiliary group
+// and proves that the ciphertext and the commitment contain the discrete
+// logarithm of x.
+func (p Prover[C]) Prove(
+	x dlog.Word[C],
+	w dlog.Witness[C],
+	label []byte,
+) (Proof, error) {
+	b, err := makeBounds(p.gen, p.pk)
+	if err != nil {
+		return nil, err
+	}
+	m := w.Int()
+	r, err := rand.Int(p.rnd, b.r)
+	if err != nil {
+		return nil, fmt.Errorf("sampling randomness: %w", err)
+	}
+	ct := encrypt[C](p.pk, m, r, label)
+	vBase := p.pk.vBase(ct.u, ct.e, label)
+	s, err := rand.Int(p.rnd, b.s)
+	if err != nil {
+		return nil, fmt.Errorf("sampling randomness: %w", err)
+	}
+	cm := p.pk.auxCommit(m, s)
+
+	for {
+		rr, err := rand.Int(p.rnd, b.zr)
+		if err != nil {
+			return nil, fmt.Errorf("sampling randomness: %w", err)
+		}
+		mm, err := rand.Int(p.rnd, b.zm)
+		if err != nil {
+			return nil, fmt.Errorf("sampling randomness: %w", err)
+		}
+		ss, err := rand.Int(p.rnd, b.zs)
+		if err != nil {
+			return nil, fmt.Errorf("sampling randomness: %w", err)
+		}
+
+		rr2 := new(big.Int).Lsh(rr, 1)
+		tu := new(big.Int).Exp(p.pk.g, rr2, p.pk.n2)
+		te := new(big.Int).Exp(p.pk.y1, rr2, p.pk.n2)
+		te.Mul(te, p.pk.hPow(new(big.Int).Lsh(mm, 1)))
+		te.Mod(te, p.pk.n2)
+		tv := new(big.Int).Exp(vBase, rr2, p.pk.n2)
+		tc := p.pk.auxCommit(mm, ss)
+		tx := p.gen.MulBase(scalar(p.gen, mm))
+		c := deriveChallenge(p.pk, x, ct, cm, tu, te, tv, tc, tx)
+
+		zr := new(big.Int).Sub(rr, new(big.Int).Mul(c, r))
+		zs := new(big.Int).Sub(ss, new(big.Int).Mul(c, s))
+		zm := new(big.Int).Sub(mm, new(big.Int).Mul(c, m))
+		if zr.Sign() < 0 || zs.Sign() < 0 || zm.Sign() < 0 {
+			// Happens with probability at most 3*2^-slackBits.
+			continue
+		}
+		return encodeProof(p.pk, b, proof[C]{
+			ct: ct,
+			cm: cm,
+			c:  c,
+			zr: zr,
+			zs: zs,
+			zm: zm,
+		}), nil
+	}
+}
+
+// bounds holds the exclusive upper bounds of the encryption and commitment
+// randomness and the responses.
+type bounds struct {
+	r, s, zr, zs, zm *big.Int
+}
+
+func makeBounds[C curve.Curve](gen curve.Generator[C], pk *PublicKey) (bounds, error) {
+	zm := new(big.Int).Lsh(gen.GeneratorOrder(), challengeBits+slackBits)
+	// Plaintexts extracted from a valid proof are bounded by zm and must be
+	// recovered uniquely modulo n.
+	if zm.BitLen() >= pk.n.BitLen()-1 {
+		return bounds{}, fmt.Errorf("modulus too small for curve: %d bits", pk.n.BitLen())
+	}
+	r := new(big.Int).Rsh(pk.n, 2)
+	s := new(big.Int).Rsh(pk.nAux, 2)
+	return bounds{
+		r:  r,
+		s:  s,
+		zr: new(big.Int).Lsh(r, challengeBits+slackBits),
+		zs: new(big.Int).Lsh(s, challengeBits+slackBits),
+		zm: zm,
+	}, nil
+}
+
+// deriveChallenge hashes the statement and the commitments to a challenge of
+// challengeBits bits.
+func deriveChallenge[C curve.Curve](
+	pk *PublicKey,
+	x dlog.Word[C],
+	ct Ciphertext[C],
+	cm *big.Int,
+	tu, te, tv, tc *big.Int,
+	tx curve.Point[C],
+) *big.Int {
+	h := sha256.New()
+	h.Write(challengeDST)
+	for _, a := range []*big.Int{
+		pk.n, pk.g, pk.y1, pk.y2, pk.y3, pk.nAux, pk.gAux, pk.hAux,
+		ct.u, ct.e, ct.v, cm,
+	} {
+		writeHash(h, a.Bytes())
+	}
+	writeHash(h, ct.label)
+	writeHash(h, x.CompressedBytes())
+	for _, a := range []*big.Int{tu, te, tv, tc} {
+		writeHash(h, a.Bytes())
+	}
+	writeHash(h, tx.CompressedBytes())
+	return new(big.Int).SetBytes(h.Sum(nil)[:challengeBits/8])
+}
+
+// scalar reduces a modulo the group order.
+func scalar[C curve.Curve](gen curve.Generator[C], a *big.Int) curve.Scalar[C] {
+	return gen.NewScalar(new(big.Int).Mod(a, gen.GeneratorOrder()))
+}
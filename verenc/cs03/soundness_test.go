@@ -0,0 +1,109 @@
+package cs03
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
+)
+
+// TestVerifyProof_halfPlaintext checks that a prover cannot exploit the public
+// order n of h. Without the auxiliary commitment, a prover could encrypt
+// (s+n)/2 for an odd s = 2w mod q, so that h^(2m) = h^s, and answer an even
+// challenge c with zm = mm - (c/2)*s. All equations over Z*_{n^2} and the
+// curve hold for such a proof, but the ciphertext does not decrypt to w.
+func TestVerifyProof_halfPlaintext(t *testing.T) {
+	var g curve.Generator[secp256k1.Curve] = secp256k1.NewGenerator()
+	rnd := rand.Reader
+	sk, err := GenerateKey(rnd, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk := sk.Public()
+	b, err := makeBounds(g, pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := g.RandomScalar(rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := g.MulBase(w)
+	label := []byte("label")
+
+	s := new(big.Int).Lsh(w.Int(), 1)
+	s.Mod(s, g.GeneratorOrder())
+	if s.Bit(0) == 0 {
+		s.Add(s, g.GeneratorOrder())
+	}
+	m := new(big.Int).Add(s, pk.n)
+	m.Rsh(m, 1)
+
+	r, err := rand.Int(rnd, b.r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct := encrypt[secp256k1.Curve](pk, m, r, label)
+	vBase := pk.vBase(ct.u, ct.e, label)
+
+	// The prover cannot open a commitment to s/2, so it commits to w.
+	sAux, err := rand.Int(rnd, b.s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cm := pk.auxCommit(w.Int(), sAux)
+
+	for i := 0; i < 64; i++ {
+		rr, err := rand.Int(rnd, b.zr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mm, err := rand.Int(rnd, b.zm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ss, err := rand.Int(rnd, b.zs)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rr2 := new(big.Int).Lsh(rr, 1)
+		tu := new(big.Int).Exp(pk.g, rr2, pk.n2)
+		te := new(big.Int).Exp(pk.y1, rr2, pk.n2)
+		te.Mul(te, pk.hPow(new(big.Int).Lsh(mm, 1)))
+		te.Mod(te, pk.n2)
+		tv := new(big.Int).Exp(vBase, rr2, pk.n2)
+		tc := pk.auxCommit(mm, ss)
+		tx := g.MulBase(scalar(g, mm))
+		c := deriveChallenge[secp256k1.Curve](pk, x, ct, cm, tu, te, tv, tc, tx)
+		if c.Bit(0) == 1 {
+			continue
+		}
+
+		zr := new(big.Int).Sub(rr, new(big.Int).Mul(c, r))
+		zs := new(big.Int).Sub(ss, new(big.Int).Mul(c, sAux))
+		zm := new(big.Int).Rsh(c, 1)
+		zm.Mul(zm, s)
+		zm.Sub(mm, zm)
+		if zr.Sign() < 0 || zs.Sign() < 0 || zm.Sign() < 0 {
+			continue
+		}
+		proof := encodeProof(pk, b, proof[secp256k1.Curve]{
+			ct: ct,
+			cm: cm,
+			c:  c,
+			zr: zr,
+			zs: zs,
+			zm: zm,
+		})
+		v := NewVerifier[secp256k1.Curve](g, pk)
+		if _, err := v.VerifyProof(x, label, proof); err == nil {
+			t.Fatal("proof for a ciphertext of (s+n)/2 should not verify")
+		}
+		return
+	}
+	t.Fatal("no even challenge found")
+}
@@ -0,0 +1,72 @@
+package cs03
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/sigma/dlog"
+)
+
+type Verifier[C curve.Curve] struct {
+	gen curve.Generator[C]
+	pk  *PublicKey
+}
+
+func NewVerifier[C curve.Curve](
+	gen curve.Generator[C],
+	pk *PublicKey,
+) Verifier[C] {
+	return Verifier[C]{
+		gen: gen,
+		pk:  pk,
+	}
+}
+
+// VerifyProof verifies a proof for x under the given label and returns the
+// ciphertext on success.
+func (v Verifier[C]) VerifyProof(
+	x dlog.Word[C],
+	label []byte,
+	proof Proof,
+) (Ciphertext[C], error) {
+	b, err := makeBounds(v.gen, v.pk)
+	if err != nil {
+		return Ciphertext[C]{}, err
+	}
+	pr, err := decodeProof[C](v.pk, b, proof, label)
+	if err != nil {
+		return Ciphertext[C]{}, fmt.Errorf("decoding proof: %w", err)
+	}
+	ct := pr.ct
+	pk := v.pk
+
+	// Recompute the commitments from the challenge and the responses.
+	c2 := new(big.Int).Lsh(pr.c, 1)
+	zr2 := new(big.Int).Lsh(pr.zr, 1)
+	tu := expMul(pk.n2, ct.u, c2, pk.g, zr2)
+	te := expMul(pk.n2, ct.e, c2, pk.y1, zr2)
+	te.Mul(te, pk.hPow(new(big.Int).Lsh(pr.zm, 1)))
+	te.Mod(te, pk.n2)
+	tv := expMul(pk.n2, ct.v, c2, pk.vBase(ct.u, ct.e, label), zr2)
+	tc := expMul(pk.nAux, pr.cm, pr.c, pk.gAux, pr.zm)
+	tc.Mul(tc, new(big.Int).Exp(pk.hAux, pr.zs, pk.nAux))
+	tc.Mod(tc, pk.nAux)
+	tx := curve.MultiScalarMul(
+		[]curve.Point[C]{x, v.gen.Generator()},
+		[]curve.Scalar[C]{scalar(v.gen, pr.c), scalar(v.gen, pr.zm)},
+	)
+
+	c := deriveChallenge(pk, x, ct, pr.cm, tu, te, tv, tc, tx)
+	if c.Cmp(pr.c) != 0 {
+		return Ciphertext[C]{}, fmt.Errorf("invalid proof")
+	}
+	return ct, nil
+}
+
+// expMul computes a^x * b^y mod n.
+func expMul(n, a, x, b, y *big.Int) *big.Int {
+	r := new(big.Int).Exp(a, x, n)
+	r.Mul(r, new(big.Int).Exp(b, y, n))
+	return r.Mod(r, n)
+}
@@ -0,0 +1,54 @@
+package elgamal
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/elgamal/enc"
+	"github.com/matthiasgeihs/go-curve/sigma/dlog"
+)
+
+// babySteps is the number of baby steps of the discrete logarithm search.
+const babySteps = 1 << (chunkBits / 2)
+
+type Decrypter[C curve.Curve] struct {
+	gen   curve.Generator[C]
+	sk    enc.SecretKey[C]
+	table *enc.DLogTable[C]
+}
+
+func NewDecrypter[C curve.Curve](
+	gen curve.Generator[C],
+	sk enc.SecretKey[C],
+) Decrypter[C] {
+	return Decrypter[C]{
+		gen:   gen,
+		sk:    sk,
+		table: enc.NewDLogTable(gen, babySteps),
+	}
+}
+
+// Decrypt decrypts the chunks and returns the recombined witness for x.
+func (d Decrypter[C]) Decrypt(
+	ct Ciphertext[C],
+	x dlog.Word[C],
+) (dlog.Witness[C], error) {
+	w := new(big.Int)
+	for i := len(ct.chunks) - 1; i >= 0; i-- {
+		ch := ct.chunks[i]
+		m := ch.c.Sub(curve.MulSecret[C](ch.a, d.sk))
+		mi, err := d.table.DLog(m, 0, 1<<chunkBits-1)
+		if err != nil {
+			return nil, fmt.Errorf("decrypting chunk %d: %w", i, err)
+		}
+		w.Lsh(w, chunkBits)
+		w.Or(w, big.NewInt(mi))
+	}
+
+	s := d.gen.NewScalar(w.Mod(w, d.gen.GeneratorOrder()))
+	if !d.gen.MulBase(s).Equal(x) {
+		return nil, fmt.Errorf("plaintext is not a witness")
+	}
+	return dlog.Witness[C](s), nil
+}
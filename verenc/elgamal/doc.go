@@ -0,0 +1,12 @@
+// elgamal implements verifiable encryption of discrete logarithms under
+// exponential ElGamal, for trustees holding an elgamal/enc key on the same
+// curve.
+//
+// The witness w of a dlog word x = w*G is split into bits, each of which is
+// encrypted as (r*G, b*G + r*Y) under the trustee key Y and proven to encrypt
+// 0 or 1 with a disjunctive Chaum-Pedersen proof. Bits are homomorphically
+// recombined into chunks of chunkBits bits, and a Chaum-Pedersen proof shows
+// that the recombined chunks encrypt the discrete logarithm of x. The proofs
+// are made non-interactive via Fiat-Shamir. Decryption recovers each chunk by
+// a baby-step giant-step search over [0, 2^chunkBits).
+package elgamal
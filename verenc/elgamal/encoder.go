@@ -0,0 +1,172 @@
+package elgamal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/elgamal/enc"
+	"github.com/matthiasgeihs/go-curve/sigma/dlog"
+)
+
+// version is the version of the proof encoding.
+const version byte = 1
+
+// bitProof holds the encryption (a, c) of a bit together with a proof that it
+// encrypts 0 or 1. The challenge c1 = ch - c0 is implicit.
+type bitProof[C curve.Curve] struct {
+	a, c       curve.Point[C]
+	c0, s0, s1 curve.Scalar[C]
+}
+
+type proof[C curve.Curve] struct {
+	ch   curve.Scalar[C]
+	bits []bitProof[C]
+	s    curve.Scalar[C]
+}
+
+// encodeProof encodes the proof as a version byte followed by the fixed-length
+// encodings of the challenge, the bit proofs and the final response.
+func encodeProof[C curve.Curve](gen curve.Generator[C], pr proof[C]) (Proof, error) {
+	pointLen, _ := elementSizes(gen)
+	var buf bytes.Buffer
+	buf.WriteByte(version)
+	writePoint := func(p curve.Point[C]) error {
+		b := p.CompressedBytes()
+		if len(b) != pointLen {
+			return fmt.Errorf("unexpected point encoding length: %d", len(b))
+		}
+		buf.Write(b)
+		return nil
+	}
+	writeScalar := func(s curve.Scalar[C]) {
+		buf.Write(s.Bytes())
+	}
+
+	writeScalar(pr.ch)
+	for _, bp := range pr.bits {
+		if err := writePoint(bp.a); err != nil {
+			return nil, err
+		}
+		if err := writePoint(bp.c); err != nil {
+			return nil, err
+		}
+		writeScalar(bp.c0)
+		writeScalar(bp.s0)
+		writeScalar(bp.s1)
+	}
+	writeScalar(pr.s)
+	if buf.Len() != 1+proofSize(gen) {
+		return nil, fmt.Errorf("unexpected scalar encoding length")
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeProof[C curve.Curve](gen curve.Generator[C], data []byte) (proof[C], error) {
+	if len(data) == 0 || data[0] != version {
+		return proof[C]{}, fmt.Errorf("unsupported version")
+	}
+	data = data[1:]
+	if len(data) != proofSize(gen) {
+		return proof[C]{}, fmt.Errorf("invalid length: %d", len(data))
+	}
+	pointLen, scalarLen := elementSizes(gen)
+	var err error
+	// DecodePoint only returns points of the prime-order subgroup. A bit
+	// encryption with a small-order component could otherwise pass the bit
+	// proofs, as their verification equations hold up to torsion, and still
+	// decrypt to a value other than 0 or 1.
+	readPoint := func() curve.Point[C] {
+		b := data[:pointLen]
+		data = data[pointLen:]
+		if err != nil {
+			return nil
+		}
+		p, errDec := gen.DecodePoint(b)
+		if errDec != nil {
+			err = fmt.Errorf("decoding point: %w", errDec)
+		}
+		return p
+	}
+	readScalar := func() curve.Scalar[C] {
+		b := data[:scalarLen]
+		data = data[scalarLen:]
+		if err != nil {
+			return nil
+		}
+		s, errDec := gen.DecodeScalar(b)
+		if errDec != nil {
+			err = fmt.Errorf("decoding scalar: %w", errDec)
+		}
+		return s
+	}
+
+	pr := proof[C]{
+		ch:   readScalar(),
+		bits: make([]bitProof[C], numBits(gen)),
+	}
+	for i := range pr.bits {
+		pr.bits[i] = bitProof[C]{
+			a:  readPoint(),
+			c:  readPoint(),
+			c0: readScalar(),
+			s0: readScalar(),
+			s1: readScalar(),
+		}
+	}
+	pr.s = readScalar()
+	if err != nil {
+		return proof[C]{}, err
+	}
+	return pr, nil
+}
+
+// elementSizes returns the lengths of encoded points and scalars.
+func elementSizes[C curve.Curve](gen curve.Generator[C]) (int, int) {
+	return len(gen.Generator().CompressedBytes()), len(gen.One().Bytes())
+}
+
+// proofSize returns the length of an encoded proof without version byte.
+func proofSize[C curve.Curve](gen curve.Generator[C]) int {
+	pointLen, scalarLen := elementSizes(gen)
+	return 2*scalarLen + numBits(gen)*(2*pointLen+3*scalarLen)
+}
+
+// transcript accumulates the length-prefixed inputs of the Fiat-Shamir hash.
+type transcript[C curve.Curve] struct {
+	buf bytes.Buffer
+}
+
+func newTranscript[C curve.Curve](
+	label []byte,
+	pk enc.PubKey[C],
+	x dlog.Word[C],
+) *transcript[C] {
+	t := &transcript[C]{}
+	t.write(label)
+	t.writePoints(pk, x)
+	return t
+}
+
+func (t *transcript[C]) write(data []byte) {
+	var l [8]byte
+	binary.BigEndian.PutUint64(l[:], uint64(len(data)))
+	t.buf.Write(l[:])
+	t.buf.Write(data)
+}
+
+func (t *transcript[C]) writePoints(ps ...curve.Point[C]) {
+	for _, p := range ps {
+		t.write(p.CompressedBytes())
+	}
+}
+
+// writeBit writes the bit encryption and the commitments of both branches.
+func (t *transcript[C]) writeBit(bp bitProof[C], t0, t1 [2]curve.Point[C]) {
+	t.writePoints(bp.a, bp.c, t0[0], t0[1], t1[0], t1[1])
+}
+
+func (t *transcript[C]) challenge(gen curve.Generator[C]) curve.Scalar[C] {
+	return gen.HashToScalar(challengeDST, t.buf.Bytes())
+}
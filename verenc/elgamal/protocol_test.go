@@ -0,0 +1,155 @@
+package elgamal_test
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	ed "filippo.io/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/curve/edwards25519"
+	"github.com/matthiasgeihs/go-curve/curve/ristretto255"
+	"github.com/matthiasgeihs/go-curve/curve/secp256k1"
+	"github.com/matthiasgeihs/go-curve/elgamal/enc"
+	"github.com/matthiasgeihs/go-curve/sigma/dlog"
+	"github.com/matthiasgeihs/go-curve/verenc"
+	"github.com/matthiasgeihs/go-curve/verenc/elgamal"
+)
+
+type C = secp256k1.Curve
+
+var _ verenc.Prover[dlog.Word[C], dlog.Witness[C]] = elgamal.Prover[C]{}
+var _ verenc.Verifier[dlog.Word[C], elgamal.Ciphertext[C]] = elgamal.Verifier[C]{}
+var _ verenc.Decrypter[dlog.Word[C], dlog.Witness[C], elgamal.Ciphertext[C]] = elgamal.Decrypter[C]{}
+
+func TestProtocol_secp256k1(t *testing.T) {
+	testProtocol[secp256k1.Curve](t, secp256k1.NewGenerator())
+}
+
+func TestProtocol_edwards25519(t *testing.T) {
+	testProtocol[edwards25519.Curve](t, edwards25519.NewGenerator())
+}
+
+func TestProtocol_ristretto255(t *testing.T) {
+	testProtocol[ristretto255.Curve](t, ristretto255.NewGenerator())
+}
+
+// TestProtocol_torsion checks that a bit encryption with a component of small
+// order is rejected.
+func TestProtocol_torsion(t *testing.T) {
+	g := edwards25519.NewGenerator()
+	rnd := rand.Reader
+	cipher := enc.NewCipher[edwards25519.Curve](g, rnd)
+	_, pk, err := cipher.KeyGen()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := elgamal.NewProver[edwards25519.Curve](g, pk, rnd)
+	v := elgamal.NewVerifier[edwards25519.Curve](g, pk)
+
+	w, err := g.RandomScalar(rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := g.MulBase(w)
+	label := []byte("label")
+	proof, err := p.Prove(x, w, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Add the point of order 2 to the encryption c of the first bit, which
+	// follows the version byte, the challenge and a.
+	b, _ := hex.DecodeString("ecffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff7f")
+	t2, err := new(ed.Point).SetBytes(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const offset = 1 + 32 + 32
+	c, err := new(ed.Point).SetBytes(proof[offset : offset+32])
+	if err != nil {
+		t.Fatal(err)
+	}
+	tainted := append(elgamal.Proof(nil), proof...)
+	copy(tainted[offset:], c.Add(c, t2).Bytes())
+	// The proof must already be rejected when decoding the point, before its
+	// hash is checked, as a prover grinding the challenges could otherwise
+	// cancel the torsion in the verification equations.
+	_, err = v.VerifyProof(x, label, tainted)
+	if err == nil || !strings.Contains(err.Error(), "decoding point") {
+		t.Errorf("bit encryption with torsion should be rejected when decoding, got %v", err)
+	}
+}
+
+func testProtocol[C curve.Curve](t *testing.T, g curve.Generator[C]) {
+	rnd := rand.Reader
+	cipher := enc.NewCipher(g, rnd)
+	sk, pk, err := cipher.KeyGen()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := elgamal.NewProver(g, pk, rnd)
+	v := elgamal.NewVerifier(g, pk)
+	d := elgamal.NewDecrypter(g, sk)
+
+	w, err := g.RandomScalar(rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := g.MulBase(w)
+	label := []byte("label")
+
+	proof, err := p.Prove(x, w, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct, err := v.VerifyProof(x, label, proof)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wDec, err := d.Decrypt(ct, x)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !wDec.Equal(w) {
+		t.Error("decrypted witness should equal witness")
+	}
+
+	// Invalid statements and proofs are rejected.
+	if _, err := v.VerifyProof(x, []byte("other"), proof); err == nil {
+		t.Error("proof should not verify under a different label")
+	}
+	if _, err := v.VerifyProof(x.Add(g.Generator()), label, proof); err == nil {
+		t.Error("proof should not verify for a different word")
+	}
+	if _, err := d.Decrypt(ct, x.Add(g.Generator())); err == nil {
+		t.Error("ciphertext should not decrypt to a witness for a different word")
+	}
+	for _, i := range []int{0, 1, len(proof) / 2, len(proof) - 1} {
+		tampered := append(elgamal.Proof(nil), proof...)
+		tampered[i] ^= 1
+		if _, err := v.VerifyProof(x, label, tampered); err == nil {
+			t.Errorf("proof with byte %d flipped should not verify", i)
+		}
+	}
+	if _, err := v.VerifyProof(x, label, proof[:len(proof)-1]); err == nil {
+		t.Error("truncated proof should not verify")
+	}
+	if _, err := v.VerifyProof(x, label, append(proof, 0)); err == nil {
+		t.Error("extended proof should not verify")
+	}
+
+	// A proof for a wrong witness is rejected.
+	w2, err := g.RandomScalar(rnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof2, err := p.Prove(x, w2, label)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := v.VerifyProof(x, label, proof2); err == nil {
+		t.Error("proof for wrong witness should not verify")
+	}
+}
@@ -0,0 +1,149 @@
+package elgamal
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/elgamal/enc"
+	"github.com/matthiasgeihs/go-curve/sigma/dlog"
+	"github.com/matthiasgeihs/go-curve/verenc"
+)
+
+// chunkBits is the bit length of the chunks recovered by decryption.
+const chunkBits = 16
+
+// challengeDST is the domain separation tag of the Fiat-Shamir challenge.
+var challengeDST = []byte("go-curve-verenc-elgamal-v1")
+
+// Proof is a serialized proof containing the ciphertext.
+type Proof = verenc.Proof
+
+type Prover[C curve.Curve] struct {
+	gen curve.Generator[C]
+	pk  enc.PubKey[C]
+	rnd io.Reader
+}
+
+func NewProver[C curve.Curve](
+	gen curve.Generator[C],
+	pk enc.PubKey[C],
+	rnd io.Reader,
+) Prover[C] {
+	return Prover[C]{
+		gen: gen,
+		pk:  pk,
+		rnd: rnd,
+	}
+}
+
+// Prove encrypts w bit by bit and proves that the bits encrypt the discrete
+// logarithm of x. Both branches of each bit proof are computed and combined by
+// arithmetic selection on the bit, so the control flow does not depend on w.
+func (p Prover[C]) Prove(
+	x dlog.Word[C],
+	w dlog.Witness[C],
+	label []byte,
+) (Proof, error) {
+	n := numBits(p.gen)
+	wInt := w.Int()
+	g := p.gen.Generator()
+	pow2 := powersOfTwo(p.gen, n)
+
+	bits := make([]bitProof[C], n)
+	bs := make([]curve.Scalar[C], n)
+	rs := make([]curve.Scalar[C], n)
+	ks := make([]curve.Scalar[C], n)
+	cSims := make([]curve.Scalar[C], n)
+	sSims := make([]curve.Scalar[C], n)
+	t := newTranscript[C](label, p.pk, x)
+	rSum := p.gen.Zero()
+	for i := range bits {
+		var r, k, cSim, sSim curve.Scalar[C]
+		for _, s := range []*curve.Scalar[C]{&r, &k, &cSim, &sSim} {
+			var err error
+			*s, err = p.gen.RandomScalar(p.rnd)
+			if err != nil {
+				return nil, fmt.Errorf("sampling scalar: %w", err)
+			}
+		}
+
+		b := p.gen.NewScalar(new(big.Int).SetUint64(uint64(wInt.Bit(i))))
+		a := p.gen.MulBase(r)
+		c := curve.MulSecret[C](p.pk, r).Add(p.gen.MulBase(b))
+		// cSub is c - (1-b)*G, as used by the simulated branch.
+		cSub := selectPoint(b, c.Sub(g), c)
+
+		// Commit to branch b and simulate branch 1-b, where branch j states
+		// that (a, c - j*G) = r*(G, Y).
+		tReal := [2]curve.Point[C]{p.gen.MulBase(k), curve.MulSecret[C](p.pk, k)}
+		tSim := [2]curve.Point[C]{
+			p.gen.MulBase(sSim).Sub(curve.MulSecret(a, cSim)),
+			curve.MulSecret[C](p.pk, sSim).Sub(curve.MulSecret(cSub, cSim)),
+		}
+		var t0, t1 [2]curve.Point[C]
+		for j := range t0 {
+			t0[j] = selectPoint(b, tReal[j], tSim[j])
+			t1[j] = selectPoint(b, tSim[j], tReal[j])
+		}
+		bits[i] = bitProof[C]{a: a, c: c}
+		t.writeBit(bits[i], t0, t1)
+		bs[i], rs[i], ks[i], cSims[i], sSims[i] = b, r, k, cSim, sSim
+		rSum = rSum.Add(r.Mul(pow2[i]))
+	}
+
+	// Prove that the recombined ciphertext (A, C) satisfies
+	// (A, C - x) = rSum*(G, Y).
+	k, err := p.gen.RandomScalar(p.rnd)
+	if err != nil {
+		return nil, fmt.Errorf("sampling scalar: %w", err)
+	}
+	t.writePoints(p.gen.MulBase(k), curve.MulSecret[C](p.pk, k))
+	ch := t.challenge(p.gen)
+
+	// Complete the real branches such that c0 + c1 = ch.
+	for i := range bits {
+		bp := &bits[i]
+		cReal := ch.Sub(cSims[i])
+		sReal := ks[i].Add(cReal.Mul(rs[i]))
+		bp.c0 = selectScalar(bs[i], cReal, cSims[i])
+		bp.s0 = selectScalar(bs[i], sReal, sSims[i])
+		bp.s1 = selectScalar(bs[i], sSims[i], sReal)
+	}
+	s := k.Add(ch.Mul(rSum))
+
+	return encodeProof(p.gen, proof[C]{
+		ch:   ch,
+		bits: bits,
+		s:    s,
+	})
+}
+
+// selectScalar returns x if b is 0 and y if b is 1, computed as x + b*(y-x).
+func selectScalar[C curve.Curve](b, x, y curve.Scalar[C]) curve.Scalar[C] {
+	return x.Add(b.Mul(y.Sub(x)))
+}
+
+// selectPoint returns x if b is 0 and y if b is 1, computed as x + b*(y-x).
+func selectPoint[C curve.Curve](b curve.Scalar[C], x, y curve.Point[C]) curve.Point[C] {
+	return x.Add(curve.MulSecret(y.Sub(x), b))
+}
+
+// numBits returns the number of encrypted bits, which covers the group order
+// with whole chunks.
+func numBits[C curve.Curve](gen curve.Generator[C]) int {
+	l := gen.GeneratorOrder().BitLen()
+	return (l + chunkBits - 1) / chunkBits * chunkBits
+}
+
+// powersOfTwo returns the scalars 2^i for i < n.
+func powersOfTwo[C curve.Curve](gen curve.Generator[C], n int) []curve.Scalar[C] {
+	q := gen.GeneratorOrder()
+	pow2 := make([]curve.Scalar[C], n)
+	for i := range pow2 {
+		v := new(big.Int).Lsh(big.NewInt(1), uint(i))
+		pow2[i] = gen.NewScalar(v.Mod(v, q))
+	}
+	return pow2
+}
@@ -0,0 +1,98 @@
+package elgamal
+
+import (
+	"fmt"
+
+	"github.com/matthiasgeihs/go-curve/curve"
+	"github.com/matthiasgeihs/go-curve/elgamal/enc"
+	"github.com/matthiasgeihs/go-curve/sigma/dlog"
+)
+
+type Verifier[C curve.Curve] struct {
+	gen curve.Generator[C]
+	pk  enc.PubKey[C]
+}
+
+// Ciphertext holds the exponential ElGamal encryptions of the chunks of the
+// witness, least significant chunk first.
+type Ciphertext[C curve.Curve] struct {
+	chunks []chunk[C]
+}
+
+// chunk is the encryption (a, c) = (r*G, m*G + r*Y) of a chunk m.
+type chunk[C curve.Curve] struct {
+	a, c curve.Point[C]
+}
+
+func NewVerifier[C curve.Curve](
+	gen curve.Generator[C],
+	pk enc.PubKey[C],
+) Verifier[C] {
+	return Verifier[C]{
+		gen: gen,
+		pk:  pk,
+	}
+}
+
+// VerifyProof verifies a proof for x under the given label and returns the
+// ciphertext on success.
+func (v Verifier[C]) VerifyProof(
+	x dlog.Word[C],
+	label []byte,
+	proof Proof,
+) (Ciphertext[C], error) {
+	pr, err := decodeProof(v.gen, proof)
+	if err != nil {
+		return Ciphertext[C]{}, fmt.Errorf("decoding proof: %w", err)
+	}
+	g := v.gen.Generator()
+	pow2 := powersOfTwo(v.gen, len(pr.bits))
+
+	// Recompute the commitments of the bit proofs from the responses.
+	t := newTranscript[C](label, v.pk, x)
+	as := make([]curve.Point[C], len(pr.bits))
+	cs := make([]curve.Point[C], len(pr.bits))
+	for i, bp := range pr.bits {
+		c1 := pr.ch.Sub(bp.c0)
+		t0 := [2]curve.Point[C]{
+			mulAdd(g, bp.s0, bp.a, bp.c0.Neg()),
+			mulAdd[C](v.pk, bp.s0, bp.c, bp.c0.Neg()),
+		}
+		t1 := [2]curve.Point[C]{
+			mulAdd(g, bp.s1, bp.a, c1.Neg()),
+			mulAdd[C](v.pk, bp.s1, bp.c.Sub(g), c1.Neg()),
+		}
+		t.writeBit(bp, t0, t1)
+		as[i], cs[i] = bp.a, bp.c
+	}
+
+	// Recompute the commitment of the proof for the recombined ciphertext.
+	a := curve.MultiScalarMul(as, pow2)
+	c := curve.MultiScalarMul(cs, pow2)
+	t.writePoints(
+		mulAdd(g, pr.s, a, pr.ch.Neg()),
+		mulAdd[C](v.pk, pr.s, c.Sub(x), pr.ch.Neg()),
+	)
+
+	if !t.challenge(v.gen).Equal(pr.ch) {
+		return Ciphertext[C]{}, fmt.Errorf("invalid proof")
+	}
+
+	// Recombine the bits into chunks.
+	ct := Ciphertext[C]{
+		chunks: make([]chunk[C], len(pr.bits)/chunkBits),
+	}
+	for i := range ct.chunks {
+		l, u := i*chunkBits, (i+1)*chunkBits
+		ct.chunks[i] = chunk[C]{
+			a: curve.MultiScalarMul(as[l:u], pow2[:chunkBits]),
+			c: curve.MultiScalarMul(cs[l:u], pow2[:chunkBits]),
+		}
+	}
+	return ct, nil
+}
+
+// mulAdd computes s*p + t*q.
+func mulAdd[C curve.Curve](p curve.Point[C], s curve.Scalar[C], q curve.Point[C], t curve.Scalar[C]) curve.Point[C] {
+	return curve.MultiScalarMul([]curve.Point[C]{p, q}, []curve.Scalar[C]{s, t})
+}
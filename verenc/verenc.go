@@ -0,0 +1,20 @@
+// verenc defines the interfaces shared by the non-interactive verifiable
+// encryption schemes in its subpackages. A prover encrypts a witness w for a
+// word x and proves that the ciphertext decrypts to a valid witness. Callers
+// that only rely on these interfaces can switch between schemes.
+package verenc
+
+// Proof is a serialized non-interactive proof that contains the ciphertext.
+type Proof []byte
+
+type Prover[X, W any] interface {
+	Prove(x X, w W, label []byte) (Proof, error)
+}
+
+type Verifier[X, CT any] interface {
+	VerifyProof(x X, label []byte, proof Proof) (CT, error)
+}
+
+type Decrypter[X, W, CT any] interface {
+	Decrypt(ct CT, x X) (W, error)
+}